@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+var abbrMap = &sync.Map{}
+
+// _baseAbbreviations Word and its abbreviation used by Abbreviate
+var _baseAbbreviations = map[string]string{
+	"account":        "acct",
+	"address":        "addr",
+	"administrator":  "admin",
+	"application":    "app",
+	"attribute":      "attr",
+	"authentication": "authn",
+	"authorization":  "authz",
+	"average":        "avg",
+	"calculate":      "calc",
+	"configuration":  "cfg",
+	"config":         "cfg",
+	"controller":     "ctrl",
+	"database":       "db",
+	"department":     "dept",
+	"description":    "desc",
+	"destination":    "dst",
+	"development":    "dev",
+	"directory":      "dir",
+	"document":       "doc",
+	"environment":    "env",
+	"identifier":     "id",
+	"information":    "info",
+	"library":        "lib",
+	"management":     "mgmt",
+	"maximum":        "max",
+	"message":        "msg",
+	"minimum":        "min",
+	"number":         "num",
+	"organization":   "org",
+	"parameter":      "param",
+	"password":       "pwd",
+	"previous":       "prev",
+	"production":     "prod",
+	"reference":      "ref",
+	"repository":     "repo",
+	"request":        "req",
+	"response":       "resp",
+	"service":        "svc",
+	"source":         "src",
+	"specification":  "spec",
+	"statistics":     "stats",
+	"temporary":      "tmp",
+	"transaction":    "txn",
+	"utility":        "util",
+	"version":        "ver",
+}
+
+func init() {
+	for word, abbr := range _baseAbbreviations {
+		abbrMap.Store(word, abbr)
+	}
+}
+
+// AddAbbreviation Adds the abbreviation of word used by Abbreviate. Ex. AddAbbreviation("customer", "cust")
+func AddAbbreviation(word, abbr string) {
+	abbrMap.Store(strings.ToLower(word), strings.ToLower(abbr))
+}
+
+// SetAbbreviations Replaces the abbreviation dictionary used by Abbreviate
+func SetAbbreviations(abbrs map[string]string) {
+	abbrMap.Range(func(key, _ interface{}) bool {
+		abbrMap.Delete(key)
+		return true
+	})
+	for word, abbr := range abbrs {
+		AddAbbreviation(word, abbr)
+	}
+}
+
+// abbrHashLen Letters of the hash word, 26^7 covers 32 bits
+const abbrHashLen = 7
+
+// ErrUnknownCase Case name not accepted by CaseFunc
+var ErrUnknownCase = errors.New("strcase: unknown case")
+
+// Abbreviate Converts to the case style and shortens to maxLen characters. Ex. Abbreviate("DatabaseConfiguration", "snake", 10) -> "db_cfg"
+//
+// Words are shortened, longest first, until the result fits:
+// by the abbreviation dictionary ("configuration" -> "cfg"), then by removing vowels ("customer" -> "cstmr").
+// If it still does not fit, trailing words are truncated to 2 letters or dropped and a word of 7 letters hashed from str is appended.
+// The result is deterministic and is a valid string of the style.
+func Abbreviate(str, style string, maxLen int) (string, error) {
+	convert, ok := CaseFunc(style, false)
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownCase, style)
+	}
+	if maxLen < 1 {
+		return "", fmt.Errorf("strcase: invalid length %d", maxLen)
+	}
+
+	words := ParseString(str)
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	join := func(words []string) string { return convert(strings.Join(words, " ")) }
+	fits := func(s string) bool { return utf8.RuneCountInString(s) <= maxLen }
+	if s := join(words); fits(s) {
+		return s, nil
+	}
+
+	for _, shorten := range []func(string) string{abbreviateWord, removeVowels} {
+		for {
+			i := longestWord(words, shorten)
+			if i < 0 {
+				break
+			}
+			words[i] = shorten(words[i])
+			if s := join(words); fits(s) {
+				return s, nil
+			}
+		}
+	}
+
+	hash := abbreviateHash(str)
+	if maxLen <= abbrHashLen {
+		return join([]string{hash[:maxLen]}), nil
+	}
+	for len(words) > 0 {
+		if s := join(append(words[:len(words):len(words)], hash)); fits(s) {
+			return s, nil
+		}
+		last := []rune(words[len(words)-1])
+		if len(last) > 2 {
+			words[len(words)-1] = string(last[:len(last)-1])
+		} else {
+			words = words[:len(words)-1]
+		}
+	}
+	return join([]string{hash}), nil
+}
+
+// longestWord Index of the longest word changed by shorten, the first one of equal length. -1 if none
+func longestWord(words []string, shorten func(string) string) int {
+	index, max := -1, 0
+	for i, w := range words {
+		if n := utf8.RuneCountInString(w); n > max && shorten(w) != w {
+			index, max = i, n
+		}
+	}
+	return index
+}
+
+func abbreviateWord(word string) string {
+	if abbr, ok := abbrMap.Load(word); ok {
+		return abbr.(string)
+	}
+	return word
+}
+
+// removeVowels Removes vowels except the first letter. Ex. "customer" -> "cstmr"
+func removeVowels(word string) string {
+	var b strings.Builder
+	for i, r := range word {
+		if i > 0 && strings.ContainsRune("aeiou", r) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// abbreviateHash Lower case letters encoding the FNV-1a hash of str
+func abbreviateHash(str string) string {
+	h := fnv.New32a()
+	h.Write([]byte(str))
+	sum := h.Sum32()
+	hash := make([]byte, abbrHashLen)
+	for i := range hash {
+		hash[i] = byte('a' + sum%26)
+		sum /= 26
+	}
+	return string(hash)
+}
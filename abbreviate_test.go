@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"errors"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestAbbreviate(t *testing.T) {
+	tests := []struct {
+		str    string
+		style  string
+		maxLen int
+		want   string
+	}{
+		{"DatabaseConfiguration", CaseSnake, 30, "database_configuration"},
+		{"DatabaseConfiguration", CaseSnake, 15, "database_cfg"},
+		{"DatabaseConfiguration", CaseSnake, 10, "db_cfg"},
+		{"CustomerDatabaseConfigurationManagementService", CaseCamel, 20, "customerDbCfgMgmtSvc"},
+		{"customer_record", CaseKebab, 11, "cstmr-rcrd"},
+		{"CustomerDatabaseConfigurationManagementService", CaseSnake, 20, "cstmr_db_cfg_qebtyqm"},
+		{"CustomerDatabaseConfigurationManagementService", CasePascal, 3, "Qeb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.str, func(t *testing.T) {
+			got, err := Abbreviate(tt.str, tt.style, tt.maxLen)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Abbreviate(%v, %v, %d) = %v, want %v", tt.str, tt.style, tt.maxLen, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAbbreviateValid(t *testing.T) {
+	strs := []string{
+		"CustomerDatabaseConfigurationManagementService",
+		"kubernetes deployment configuration",
+		"http_request_handler_v2",
+		"user profile picture",
+	}
+	for _, style := range CaseNames() {
+		convert, _ := CaseFunc(style, false)
+		for _, str := range strs {
+			for maxLen := 1; maxLen <= 60; maxLen++ {
+				got, err := Abbreviate(str, style, maxLen)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if n := utf8.RuneCountInString(got); n > maxLen || n == 0 {
+					t.Errorf("Abbreviate(%v, %v, %d) = %v, length %d", str, style, maxLen, got, n)
+				}
+				if style != CaseMerge && convert(got) != got {
+					t.Errorf("Abbreviate(%v, %v, %d) = %v, not %v", str, style, maxLen, got, style)
+				}
+				if again, _ := Abbreviate(str, style, maxLen); again != got {
+					t.Errorf("Abbreviate(%v, %v, %d) is not deterministic: %v != %v", str, style, maxLen, again, got)
+				}
+			}
+		}
+	}
+}
+
+func TestAbbreviateErrors(t *testing.T) {
+	if _, err := Abbreviate("field", "unknown", 10); !errors.Is(err, ErrUnknownCase) {
+		t.Errorf("Abbreviate() error = %v, want %v", err, ErrUnknownCase)
+	}
+	if _, err := Abbreviate("field", CaseSnake, 0); err == nil {
+		t.Errorf("Abbreviate() error = nil for zero length")
+	}
+}
+
+func TestSetAbbreviations(t *testing.T) {
+	defer SetAbbreviations(_baseAbbreviations)
+
+	AddAbbreviation("Customer", "Cust")
+	if got, _ := Abbreviate("customer_record", CaseSnake, 12); got != "cust_record" {
+		t.Errorf("Abbreviate() = %v, want cust_record", got)
+	}
+	SetAbbreviations(map[string]string{"record": "rec"})
+	if got, _ := Abbreviate("customer_record", CaseSnake, 12); got != "customer_rec" {
+		t.Errorf("Abbreviate() = %v, want customer_rec", got)
+	}
+}
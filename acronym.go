@@ -1,6 +1,8 @@
 package strcase
 
 import (
+	"bufio"
+	"io"
 	"sort"
 	"strings"
 	"sync"
@@ -54,11 +56,11 @@ var _baseAcronyms = []string{
 
 func init() {
 	for _, acronym := range _baseAcronyms {
-		loadAcronym(acronym)
+		loadAcronym(acrMap, acronym)
 	}
 }
 
-func loadAcronym(acr string, variants ...string) {
+func loadAcronym(acronyms *sync.Map, acr string, variants ...string) {
 	lower := false
 	for _, v := range variants {
 		if strings.ToLower(v) == v {
@@ -70,19 +72,34 @@ func loadAcronym(acr string, variants ...string) {
 		variants = append(variants, strings.ToLower(acr))
 	}
 	for _, variant := range variants {
-		acrMap.Store(variant, []rune(acr))
+		acronyms.Store(variant, []rune(acr))
 	}
 }
 
 func AddAcronym(acr string, variants ...string) {
-	loadAcronym(acr, variants...)
+	loadAcronym(acrMap, acr, variants...)
+}
+
+// ReadAcronyms Adds acronyms from reader, one per line: "ACRONYM [variant ...]". "#" starts a comment
+func ReadAcronyms(r io.Reader) error {
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if fields := strings.Fields(line); len(fields) > 0 {
+			loadAcronym(acrMap, fields[0], fields[1:]...)
+		}
+	}
+	return s.Err()
 }
 
 func SetAcronyms(acrs map[string][]string) {
 	values := make([]string, 0, len(acrs))
 	for acr, vars := range acrs {
 		values = append(values, acr)
-		loadAcronym(acr, vars...)
+		loadAcronym(acrMap, acr, vars...)
 	}
 	sort.Strings(values)
 	acrMap.Range(func(key, value interface{}) bool {
@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+// Case names returned by DetectCase and accepted by CaseFunc
+const (
+	CaseUnknown        = "unknown"
+	CaseMerge          = "merge"
+	CaseSnake          = "snake"
+	CaseScreamingSnake = "screaming_snake"
+	CaseKebab          = "kebab"
+	CaseDot            = "dot"
+	CaseCamel          = "camel"
+	CasePascal         = "pascal"
+	CaseTitle          = "title"
+	CaseSentence       = "sentence"
+	CaseTrain          = "train"
+)
+
+type caseFuncs struct {
+	name string
+	f    func(string) string
+	fAcr func(string) string
+}
+
+// cases Order of detection, a string matching several cases is detected as the first one
+var cases = []caseFuncs{
+	{name: CaseMerge, f: ToMergeCase, fAcr: ToMergeCaseAcronym},
+	{name: CaseSnake, f: ToSnakeCase, fAcr: ToSnakeCaseAcronym},
+	{name: CaseKebab, f: ToKebabCase, fAcr: ToKebabCaseAcronym},
+	{name: CaseDot, f: ToDotCase, fAcr: ToDotCaseAcronym},
+	{name: CaseCamel, f: ToCamelCase, fAcr: ToCamelCaseAcronym},
+	{name: CasePascal, f: ToPascalCase, fAcr: ToPascalCaseAcronym},
+	{name: CaseScreamingSnake, f: ToScreamingSnakeCase, fAcr: ToScreamingSnakeCaseAcronym},
+	{name: CaseTitle, f: ToTitleCase, fAcr: ToTitleCaseAcronym},
+	{name: CaseSentence, f: ToSentenceCase, fAcr: ToSentenceCaseAcronym},
+	{name: CaseTrain, f: ToTrainCase, fAcr: ToTrainCaseAcronym},
+}
+
+// CaseFunc Converter of the case by name, acronym selects the Acronym variant. Ex. CaseFunc("snake", true) -> ToSnakeCaseAcronym
+func CaseFunc(name string, acronym bool) (func(string) string, bool) {
+	for _, c := range cases {
+		if c.name == name {
+			if acronym {
+				return c.fAcr, true
+			}
+			return c.f, true
+		}
+	}
+	return nil, false
+}
+
+// CaseNames Names of cases accepted by CaseFunc
+func CaseNames() []string {
+	names := make([]string, 0, len(cases))
+	for _, c := range cases {
+		names = append(names, c.name)
+	}
+	return names
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"unicode"
+)
+
+// specialTitleCase Unconditional titlecase mappings from SpecialCasing.txt
+// that expand one rune to several runes and are not covered by unicode.ToTitle.
+var specialTitleCase = map[rune][]rune{
+	'\u00DF': []rune("Ss"),                 // ß -> Ss
+	'\u0149': []rune("\u02BCN"),            // ŉ -> ʼN
+	'\u01F0': []rune("J\u030C"),            // ǰ -> J̌
+	'\u0390': []rune("\u0399\u0308\u0301"), // ΐ -> Ϊ́
+	'\u03B0': []rune("\u03A5\u0308\u0301"), // ΰ -> Ϋ́
+	'\u0587': []rune("\u0535\u0582"),       // և -> Եւ
+	'\u1E96': []rune("H\u0331"),            // ẖ -> H̱
+	'\u1E97': []rune("T\u0308"),            // ẗ -> T̈
+	'\u1E98': []rune("W\u030A"),            // ẘ -> W̊
+	'\u1E99': []rune("Y\u030A"),            // ẙ -> Y̊
+	'\u1E9A': []rune("A\u02BE"),            // ẚ -> Aʾ
+	'\uFB00': []rune("Ff"),                 // ﬀ -> Ff
+	'\uFB01': []rune("Fi"),                 // ﬁ -> Fi
+	'\uFB02': []rune("Fl"),                 // ﬂ -> Fl
+	'\uFB03': []rune("Ffi"),                // ﬃ -> Ffi
+	'\uFB04': []rune("Ffl"),                // ﬄ -> Ffl
+	'\uFB05': []rune("St"),                 // ﬅ -> St
+	'\uFB06': []rune("St"),                 // ﬆ -> St
+	'\uFB13': []rune("\u0544\u0576"),       // ﬓ -> Մն
+	'\uFB14': []rune("\u0544\u0565"),       // ﬔ -> Մե
+	'\uFB15': []rune("\u0544\u056B"),       // ﬕ -> Մի
+	'\uFB16': []rune("\u054E\u0576"),       // ﬖ -> Վն
+	'\uFB17': []rune("\u0544\u056D"),       // ﬗ -> Մխ
+}
+
+// toTitleRunes Capitalizes the first rune of the word with unicode.ToTitle,
+// so digraphs become titlecase ("ǆ" -> "ǅ", not "Ǆ").
+func toTitleRunes(rs []rune) []rune {
+	if len(rs) == 0 {
+		return rs
+	}
+	rs[0] = unicode.ToTitle(rs[0])
+	return rs
+}
+
+// toFullTitleRunes Capitalizes the first rune of the word with the full case mapping,
+// the first rune may expand to several runes. Ex. "ßtraße" -> "Sstraße", "ﬁle" -> "File"
+func toFullTitleRunes(rs []rune) []rune {
+	if len(rs) > 0 {
+		if title, ok := specialTitleCase[rs[0]]; ok {
+			return append(append(make([]rune, 0, len(title)+len(rs)-1), title...), rs[1:]...)
+		}
+	}
+	return toTitleRunes(rs)
+}
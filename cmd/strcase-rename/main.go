@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command strcase-rename renames identifiers of Go packages to the given case.
+//
+// Usage:
+//
+//	strcase-rename -to camel [-kinds fields,methods] [-acronym] [-w] [dir ...]
+//
+// Without -w the diff is printed and no file is written.
+// Exit codes: 0 success, 1 name collisions or type errors after renaming, 2 usage or I/O error.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/nikitaksv/strcase"
+	"github.com/nikitaksv/strcase/rename"
+)
+
+const (
+	exitOK        = 0
+	exitCollision = 1
+	exitError     = 2
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("strcase-rename", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	to := fs.String("to", "", "target case: "+strings.Join(strcase.CaseNames(), ", "))
+	kinds := fs.String("kinds", "fields", "identifiers to rename: fields, methods, consts, vars, funcs, types, locals")
+	acronym := fs.Bool("acronym", false, "replace acronyms")
+	acronyms := fs.String("acronyms", "", "file with acronyms, one per line: ACRONYM [variant ...] (implies -acronym)")
+	keepExported := fs.Bool("keep-exported", true, "keep exported state of identifiers")
+	tests := fs.Bool("tests", false, "rename declarations in _test.go files and external test packages")
+	write := fs.Bool("w", false, "write files instead of printing the diff")
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+
+	kind, err := rename.ParseKind(*kinds)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+	if *acronyms != "" {
+		f, err := os.Open(*acronyms)
+		if err != nil {
+			fmt.Fprintf(stderr, "strcase-rename: %v\n", err)
+			return exitError
+		}
+		err = strcase.ReadAcronyms(f)
+		f.Close()
+		if err != nil {
+			fmt.Fprintf(stderr, "strcase-rename: %v\n", err)
+			return exitError
+		}
+		*acronym = true
+	}
+	convert, ok := strcase.CaseFunc(*to, *acronym)
+	if !ok {
+		fmt.Fprintf(stderr, "strcase-rename: unknown case %q, use one of: %s\n", *to, strings.Join(strcase.CaseNames(), ", "))
+		return exitError
+	}
+
+	dirs := fs.Args()
+	if len(dirs) == 0 {
+		dirs = []string{"."}
+	}
+	cfg := rename.Config{Kinds: kind, Convert: convert, KeepExported: *keepExported, Tests: *tests}
+
+	results := make([]*rename.Result, 0, len(dirs))
+	code := exitOK
+	for _, dir := range dirs {
+		res, err := rename.Dir(dir, cfg)
+		if err != nil {
+			fmt.Fprintf(stderr, "strcase-rename: %v\n", err)
+			return exitError
+		}
+		for _, c := range res.Collisions {
+			fmt.Fprintln(stderr, c)
+			code = exitCollision
+		}
+		for _, err := range res.TypeErrors {
+			fmt.Fprintf(stderr, "%v (after renaming)\n", err)
+			code = exitCollision
+		}
+		results = append(results, res)
+	}
+
+	for _, res := range results {
+		if !*write {
+			fmt.Fprint(stdout, res.Diff())
+			continue
+		}
+		if code != exitOK {
+			continue
+		}
+		if err := res.Write(); err != nil {
+			fmt.Fprintf(stderr, "strcase-rename: %v\n", err)
+			return exitError
+		}
+	}
+	return code
+}
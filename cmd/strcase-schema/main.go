@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command strcase-schema renames properties of JSON Schema and OpenAPI documents to the given case.
+//
+// Usage:
+//
+//	strcase-schema -to camel [-acronym] [-indent "  "] [-w] file.json ...
+//
+// Without -w the result is printed to stdout, with -w files with collisions are not written.
+// Exit codes: 0 success, 1 property collisions, 2 usage or I/O error.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/nikitaksv/strcase"
+	"github.com/nikitaksv/strcase/schemacase"
+)
+
+const (
+	exitOK        = 0
+	exitCollision = 1
+	exitError     = 2
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("strcase-schema", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	to := fs.String("to", "", "target case: "+strings.Join(strcase.CaseNames(), ", "))
+	acronym := fs.Bool("acronym", false, "replace acronyms")
+	indent := fs.String("indent", "  ", "indent of the output, compact if empty")
+	write := fs.Bool("w", false, "write result to the source file")
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+	convert, ok := strcase.CaseFunc(*to, *acronym)
+	if !ok {
+		fmt.Fprintf(stderr, "strcase-schema: unknown case %q, use one of: %s\n", *to, strings.Join(strcase.CaseNames(), ", "))
+		return exitError
+	}
+	if fs.NArg() == 0 {
+		fmt.Fprintln(stderr, "strcase-schema: no files")
+		fs.Usage()
+		return exitError
+	}
+
+	cfg := schemacase.Config{Convert: convert, Indent: *indent}
+	code := exitOK
+	for _, name := range fs.Args() {
+		src, err := ioutil.ReadFile(name)
+		if err != nil {
+			fmt.Fprintf(stderr, "strcase-schema: %v\n", err)
+			return exitError
+		}
+		res, err := schemacase.Rename(src, cfg)
+		if err != nil {
+			fmt.Fprintf(stderr, "strcase-schema: %s: %v\n", name, err)
+			return exitError
+		}
+		for _, c := range res.Collisions {
+			fmt.Fprintf(stderr, "%s: %s\n", name, c)
+			code = exitCollision
+		}
+		if !*write {
+			stdout.Write(res.JSON)
+			if *indent == "" {
+				fmt.Fprintln(stdout)
+			}
+			continue
+		}
+		if len(res.Collisions) > 0 {
+			continue
+		}
+		fi, err := os.Stat(name)
+		if err != nil {
+			fmt.Fprintf(stderr, "strcase-schema: %v\n", err)
+			return exitError
+		}
+		if err := ioutil.WriteFile(name, res.JSON, fi.Mode()); err != nil {
+			fmt.Fprintf(stderr, "strcase-schema: %v\n", err)
+			return exitError
+		}
+	}
+	return code
+}
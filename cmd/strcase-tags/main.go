@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command strcase-tags adds and rewrites struct tags derived from field names.
+//
+// Usage:
+//
+//	strcase-tags -tags json=camel,db=snake,yaml=kebab [-struct User] [-acronym] [-overwrite] [-w] file.go ...
+//
+// Without -w the result is printed to stdout.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/nikitaksv/strcase/structtag"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("strcase-tags", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	tags := fs.String("tags", "json=camel", "tag keys and cases: key=case,...")
+	structName := fs.String("struct", "", "process only this struct")
+	acronym := fs.Bool("acronym", false, "replace acronyms")
+	overwrite := fs.Bool("overwrite", false, "rewrite explicitly set names")
+	write := fs.Bool("w", false, "write result to the source file")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() == 0 {
+		fmt.Fprintln(stderr, "strcase-tags: no files")
+		fs.Usage()
+		return 2
+	}
+
+	cfg := structtag.Config{Struct: *structName, Overwrite: *overwrite}
+	var err error
+	if cfg.Tags, err = structtag.ParseTags(*tags, *acronym); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	for _, name := range fs.Args() {
+		if err := processFile(name, cfg, *write, stdout); err != nil {
+			fmt.Fprintf(stderr, "strcase-tags: %v\n", err)
+			return 1
+		}
+	}
+	return 0
+}
+
+func processFile(name string, cfg structtag.Config, write bool, stdout io.Writer) error {
+	src, err := ioutil.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	out, err := structtag.Source(name, src, cfg)
+	if err != nil {
+		return err
+	}
+	if !write {
+		_, err = stdout.Write(out)
+		return err
+	}
+	fi, err := os.Stat(name)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(name, out, fi.Mode())
+}
@@ -0,0 +1,186 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command strcase converts arguments or stdin lines to the given case.
+//
+// Usage:
+//
+//	strcase -to snake [flags] [string ...]
+//	strcase -detect [flags] [string ...]
+//
+// Exit codes: 0 success, 1 check mode found strings not in the -to case, 2 usage or I/O error.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/nikitaksv/strcase"
+)
+
+const (
+	exitOK    = 0
+	exitCheck = 1
+	exitError = 2
+)
+
+type record struct {
+	Input    string `json:"input"`
+	Detected string `json:"detected"`
+	Output   string `json:"output,omitempty"`
+	OK       *bool  `json:"ok,omitempty"`
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("strcase", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	to := fs.String("to", "", "target case: "+strings.Join(strcase.CaseNames(), ", "))
+	acronym := fs.Bool("acronym", false, "replace acronyms")
+	acronyms := fs.String("acronyms", "", "file with acronyms, one per line: ACRONYM [variant ...] (implies -acronym)")
+	detect := fs.Bool("detect", false, "print detected case instead of converting")
+	check := fs.Bool("check", false, "print strings which are not in the -to case and exit with code 1 if any")
+	null := fs.Bool("0", false, "input and output are NUL-delimited")
+	jsonOut := fs.Bool("json", false, "print JSON lines with input, detected case and output")
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+
+	_, ok := strcase.CaseFunc(*to, false)
+	switch {
+	case *to == "" && !*detect:
+		fmt.Fprintln(stderr, "strcase: -to or -detect is required")
+		fs.Usage()
+		return exitError
+	case *to != "" && !ok:
+		fmt.Fprintf(stderr, "strcase: unknown case %q, use one of: %s\n", *to, strings.Join(strcase.CaseNames(), ", "))
+		return exitError
+	case *check && *to == "":
+		fmt.Fprintln(stderr, "strcase: -check requires -to")
+		return exitError
+	}
+
+	if *acronyms != "" {
+		if err := loadAcronyms(*acronyms); err != nil {
+			fmt.Fprintf(stderr, "strcase: %v\n", err)
+			return exitError
+		}
+		*acronym = true
+	}
+	convert, _ := strcase.CaseFunc(*to, *acronym)
+
+	delim := byte('\n')
+	if *null {
+		delim = 0
+	}
+	out := bufio.NewWriter(stdout)
+	defer out.Flush()
+	enc := json.NewEncoder(out)
+	enc.SetEscapeHTML(false)
+
+	code := exitOK
+	err := eachInput(fs.Args(), stdin, delim, func(input string) error {
+		rec := record{Input: input, Detected: strcase.DetectCase(input)}
+		if convert != nil {
+			rec.Output = convert(input)
+		}
+		if *check {
+			valid := rec.Output == input
+			rec.OK = &valid
+			if !valid {
+				code = exitCheck
+			}
+		}
+
+		switch {
+		case *jsonOut:
+			return enc.Encode(rec)
+		case *check:
+			if *rec.OK {
+				return nil
+			}
+			_, err := fmt.Fprintf(out, "%s\t%s%c", rec.Input, rec.Output, delim)
+			return err
+		case *detect:
+			_, err := fmt.Fprintf(out, "%s%c", rec.Detected, delim)
+			return err
+		default:
+			_, err := fmt.Fprintf(out, "%s%c", rec.Output, delim)
+			return err
+		}
+	})
+	if err != nil {
+		fmt.Fprintf(stderr, "strcase: %v\n", err)
+		return exitError
+	}
+	return code
+}
+
+// eachInput Calls f for each argument or, without arguments, for each delimited record of stdin
+func eachInput(args []string, stdin io.Reader, delim byte, f func(string) error) error {
+	if len(args) > 0 {
+		for _, arg := range args {
+			if err := f(arg); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	s := bufio.NewScanner(stdin)
+	s.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	// Lines may end with \r\n, records of other delimiters are kept as is
+	trim := func(rec []byte) []byte {
+		if delim == '\n' {
+			return bytes.TrimSuffix(rec, []byte{'\r'})
+		}
+		return rec
+	}
+	s.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		if i := bytes.IndexByte(data, delim); i >= 0 {
+			return i + 1, trim(data[:i]), nil
+		}
+		if atEOF && len(data) > 0 {
+			return len(data), trim(data), nil
+		}
+		return 0, nil, nil
+	})
+	for s.Scan() {
+		if err := f(s.Text()); err != nil {
+			return err
+		}
+	}
+	return s.Err()
+}
+
+// loadAcronyms Adds acronyms from file
+func loadAcronyms(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return strcase.ReadAcronyms(f)
+}
@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "strcase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	acronyms := filepath.Join(dir, "acronyms.txt")
+	if err := ioutil.WriteFile(acronyms, []byte("# custom\nGUID Guid guid\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		args  []string
+		stdin string
+		want  string
+		code  int
+	}{
+		{
+			name: "args",
+			args: []string{"-to", "snake", "fieldName", "Field Name"},
+			want: "field_name\nfield_name\n",
+		},
+		{
+			name:  "stdin",
+			args:  []string{"-to", "kebab"},
+			stdin: "fieldName\r\nFIELD_NAME\n",
+			want:  "field-name\nfield-name\n",
+		},
+		{
+			name:  "stdin crlf at eof",
+			args:  []string{"-to", "camel", "-json"},
+			stdin: "field_name\r\nfield_name\r",
+			want:  "{\"input\":\"field_name\",\"detected\":\"snake\",\"output\":\"fieldName\"}\n{\"input\":\"field_name\",\"detected\":\"snake\",\"output\":\"fieldName\"}\n",
+		},
+		{
+			name:  "null delimited keeps cr",
+			args:  []string{"-to", "camel", "-0", "-json"},
+			stdin: "field\r\x00",
+			want:  "{\"input\":\"field\\r\",\"detected\":\"unknown\",\"output\":\"field\"}\n",
+		},
+		{
+			name:  "null delimited",
+			args:  []string{"-to", "camel", "-0"},
+			stdin: "field name\x00multi\nline",
+			want:  "fieldName\x00multiLine\x00",
+		},
+		{
+			name: "screaming",
+			args: []string{"-to", "screaming_snake", "fieldName"},
+			want: "FIELD_NAME\n",
+		},
+		{
+			name: "acronym",
+			args: []string{"-to", "pascal", "-acronym", "order_id"},
+			want: "OrderID\n",
+		},
+		{
+			name: "acronyms file",
+			args: []string{"-to", "camel", "-acronyms", acronyms, "order guid"},
+			want: "orderGUID\n",
+		},
+		{
+			name: "detect",
+			args: []string{"-detect", "field_name", "fieldName", "field name"},
+			want: "snake\ncamel\nunknown\n",
+		},
+		{
+			name: "check ok",
+			args: []string{"-to", "snake", "-check", "field_name", "id"},
+			want: "",
+		},
+		{
+			name: "check failed",
+			args: []string{"-to", "snake", "-check", "field_name", "fieldName"},
+			want: "fieldName\tfield_name\n",
+			code: exitCheck,
+		},
+		{
+			name: "json",
+			args: []string{"-to", "pascal", "-json", "field_name"},
+			want: `{"input":"field_name","detected":"snake","output":"FieldName"}` + "\n",
+		},
+		{
+			name: "json check",
+			args: []string{"-to", "pascal", "-json", "-check", "field_name"},
+			want: `{"input":"field_name","detected":"snake","output":"FieldName","ok":false}` + "\n",
+			code: exitCheck,
+		},
+		{
+			name: "json detect",
+			args: []string{"-detect", "-json", "FieldName"},
+			want: `{"input":"FieldName","detected":"pascal"}` + "\n",
+		},
+		{
+			name: "unknown case",
+			args: []string{"-to", "upper", "field"},
+			code: exitError,
+		},
+		{
+			name: "no case",
+			args: []string{"field"},
+			code: exitError,
+		},
+		{
+			name: "missing acronyms file",
+			args: []string{"-to", "snake", "-acronyms", filepath.Join(dir, "missing.txt"), "field"},
+			code: exitError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			code := run(tt.args, strings.NewReader(tt.stdin), stdout, stderr)
+			if code != tt.code {
+				t.Errorf("run() = %d, want %d, stderr: %s", code, tt.code, stderr)
+			}
+			if got := stdout.String(); got != tt.want {
+				t.Errorf("run() stdout = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
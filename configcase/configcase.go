@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package configcase rewrites mapping keys of YAML and TOML documents with a strcase converter.
+//
+// Documents are not decoded: a local tokenizer finds the keys and only their text is replaced,
+// so comments, ordering, quoting and formatting are kept.
+//
+//	out, err := configcase.YAML(src, strcase.ToSnakeCase) // maxConns: 10 -> max_conns: 10
+//	out, err = configcase.TOML(src, strcase.ToSnakeCase)  // [serverOptions] -> [server_options]
+package configcase
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrDuplicateKey Several keys of a mapping or table are converted to the same name, the document is not rewritten
+var ErrDuplicateKey = errors.New("configcase: duplicate key")
+
+// keySet Original keys of a mapping or table by their converted names
+type keySet map[string]string
+
+// add Records key converted to name, returns the other key converted to name if any
+func (ks keySet) add(key, name string) (string, bool) {
+	if prev, ok := ks[name]; ok && prev != key {
+		return prev, true
+	}
+	ks[name] = key
+	return "", false
+}
+
+func duplicateKeyError(format string, line int, prev, key, name string) error {
+	return fmt.Errorf("%w: %s:%d: %q and %q convert to %q", ErrDuplicateKey, format, line, prev, key, name)
+}
+
+// edit Replacement of src[start:end]
+type edit struct {
+	start, end int
+	text       string
+}
+
+func applyEdits(src []byte, edits []edit) []byte {
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start < edits[j].start })
+	out := make([]byte, 0, len(src))
+	last := 0
+	for _, e := range edits {
+		out = append(out, src[last:e.start]...)
+		out = append(out, e.text...)
+		last = e.end
+	}
+	return append(out, src[last:]...)
+}
+
+func syntaxError(format string, line int, msg string) error {
+	return fmt.Errorf("configcase: %s:%d: %s", format, line, msg)
+}
+
+func lineOf(src []byte, offset int) int {
+	return strings.Count(string(src[:offset]), "\n") + 1
+}
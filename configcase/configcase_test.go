@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configcase
+
+import (
+	"errors"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nikitaksv/strcase"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func testGolden(t *testing.T, name string, rewrite func([]byte, func(string) string) ([]byte, error)) {
+	src, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := rewrite(src, strcase.ToSnakeCase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ext := filepath.Ext(name)
+	golden := filepath.Join("testdata", strings.TrimSuffix(name, ext)+".golden"+ext)
+	if *update {
+		if err := ioutil.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("mismatch (-update to regenerate)\ngot:\n%s\nwant:\n%s", got, want)
+	}
+
+	again, err := rewrite(got, strcase.ToSnakeCase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(again) != string(got) {
+		t.Errorf("not idempotent\ngot:\n%s", again)
+	}
+}
+
+func TestYAML(t *testing.T) {
+	testGolden(t, "config.yaml", YAML)
+}
+
+func TestTOML(t *testing.T) {
+	testGolden(t, "config.toml", TOML)
+}
+
+func TestYAMLQuoting(t *testing.T) {
+	got, err := YAML([]byte("field name: 1\nother: {field name: 2}\n"), strcase.ToTitleCase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Field Name: 1\nOther: {Field Name: 2}\n"; string(got) != want {
+		t.Errorf("YAML() = %q, want %q", got, want)
+	}
+	got, err = YAML([]byte("a_b: 1\r\n"), func(string) string { return "- x: y" })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "\"- x: y\": 1\r\n"; string(got) != want {
+		t.Errorf("YAML() = %q, want %q", got, want)
+	}
+}
+
+func TestTOMLQuoting(t *testing.T) {
+	got, err := TOML([]byte("field_name = 1\n"), strcase.ToTitleCase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "\"Field Name\" = 1\n"; string(got) != want {
+		t.Errorf("TOML() = %q, want %q", got, want)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	for _, src := range []string{"a: {b: 1\n", "a: \"unterminated\n", "a: ]\n"} {
+		if _, err := YAML([]byte(src), strcase.ToSnakeCase); err == nil {
+			t.Errorf("YAML(%q) error = nil", src)
+		}
+	}
+	for _, src := range []string{"a = \n", "a = \"x\n", "[table\n", "a = [1\n", "= 1\n"} {
+		if _, err := TOML([]byte(src), strcase.ToSnakeCase); err == nil {
+			t.Errorf("TOML(%q) error = nil", src)
+		}
+	}
+}
+
+func TestDuplicateKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		rewrite func([]byte, func(string) string) ([]byte, error)
+		src     string
+		wantErr string
+	}{
+		{"yaml", YAML, "userId: 1\nuser_id: 2\n", `configcase: duplicate key: yaml:2: "userId" and "user_id" convert to "user_id"`},
+		{"yaml nested", YAML, "a:\n  userId: 1\n  b: {c: 1}\n  user_id: 2\n", "duplicate key"},
+		{"yaml entry", YAML, "- userId: 1\n  user_id: 2\n", "duplicate key"},
+		{"yaml flow", YAML, "a: {userId: 1, user_id: 2}\n", "duplicate key"},
+		{"yaml other mappings", YAML, "a:\n  userId: 1\nb:\n  user_id: 2\n", ""},
+		{"yaml entries", YAML, "- userId: 1\n- user_id: 2\n", ""},
+		{"yaml flow entries", YAML, "a: [{userId: 1}, {user_id: 2}]\n", ""},
+		{"yaml documents", YAML, "userId: 1\n---\nuser_id: 2\n", ""},
+		{"toml", TOML, "userId = 1\nuser_id = 2\n", `configcase: duplicate key: toml:2: "userId" and "user_id" convert to "user_id"`},
+		{"toml dotted", TOML, "userId.a = 1\nuser_id.b = 2\n", "duplicate key"},
+		{"toml tables", TOML, "[userId]\n[user_id]\n", "duplicate key"},
+		{"toml table key", TOML, "[a]\nuserId = 1\n[a.user_id]\n", `"a.userId" and "a.user_id" convert to "a.user_id"`},
+		{"toml array of tables", TOML, "[[items]]\nuserId = 1\nuser_id = 2\n", `"items[0].userId" and "items[0].user_id"`},
+		{"toml inline table", TOML, "a = {userId = 1, user_id = 2}\n", "duplicate key"},
+		{"toml other tables", TOML, "[a]\nuserId = 1\n[b]\nuser_id = 2\n", ""},
+		{"toml array elements", TOML, "[[items]]\nuserId = 1\n[[items]]\nuser_id = 2\n", ""},
+		{"toml inline elements", TOML, "a = [{userId = 1}, {user_id = 2}]\n", ""},
+		{"toml same table", TOML, "a.b = 1\na.c = 2\n", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.rewrite([]byte(tt.src), strcase.ToSnakeCase)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrDuplicateKey) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,372 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configcase
+
+import (
+	"strconv"
+	"strings"
+)
+
+// TOML Rewrites keys of key/value pairs, table headers and inline tables of the TOML document.
+// Every segment of dotted keys is converted, quoted keys keep their quotes,
+// converted bare keys that are not valid bare keys are quoted. Keys with escapes are kept.
+// Returns ErrDuplicateKey if several keys of a table are converted to the same name.
+func TOML(src []byte, convert func(string) string) ([]byte, error) {
+	s := &tomlScanner{src: src, convert: convert, tables: keySet{}, arrays: map[string]int{}}
+	if err := s.document(); err != nil {
+		return nil, err
+	}
+	return applyEdits(src, s.edits), nil
+}
+
+type tomlScanner struct {
+	src     []byte
+	i       int
+	convert func(string) string
+	edits   []edit
+
+	// table Key of the current table
+	table []tomlKey
+	// tables Original keys of tables and values by their converted keys
+	tables keySet
+	// arrays Number of tables of arrays of tables by their converted keys
+	arrays map[string]int
+}
+
+// tomlKey Segment of a dotted key, index segments are elements of arrays
+type tomlKey struct {
+	key, name string
+	index     bool
+}
+
+// joinKeys Original and converted dotted keys for display, ex. "items[0].userId",
+// and the converted key as id, segments kept apart by a NUL
+func joinKeys(keys []tomlKey) (key, name, id string) {
+	var k, n, ids strings.Builder
+	for i, seg := range keys {
+		if seg.index {
+			k.WriteString("[" + seg.key + "]")
+			n.WriteString("[" + seg.name + "]")
+			ids.WriteString("\x00[" + seg.name)
+			continue
+		}
+		if i > 0 {
+			k.WriteByte('.')
+			n.WriteByte('.')
+		}
+		k.WriteString(seg.key)
+		n.WriteString(seg.name)
+		ids.WriteString("\x00" + seg.name)
+	}
+	return k.String(), n.String(), ids.String()
+}
+
+// define Records every prefix of the dotted key, returns ErrDuplicateKey if another key is converted to the same name
+func (s *tomlScanner) define(keys []tomlKey) error {
+	for i := range keys {
+		if keys[i].index {
+			continue
+		}
+		key, name, id := joinKeys(keys[:i+1])
+		if prev, dup := s.tables.add(key, id); dup {
+			return duplicateKeyError("toml", lineOf(s.src, s.i), prev, key, name)
+		}
+	}
+	return nil
+}
+
+func appendKeys(base []tomlKey, keys ...tomlKey) []tomlKey {
+	return append(append(make([]tomlKey, 0, len(base)+len(keys)), base...), keys...)
+}
+
+func (s *tomlScanner) errorf(msg string) error {
+	return syntaxError("toml", lineOf(s.src, s.i), msg)
+}
+
+func (s *tomlScanner) peek() byte {
+	if s.i < len(s.src) {
+		return s.src[s.i]
+	}
+	return 0
+}
+
+func (s *tomlScanner) hasPrefix(prefix string) bool {
+	return strings.HasPrefix(string(s.src[s.i:]), prefix)
+}
+
+func (s *tomlScanner) skipSpaces() {
+	for s.peek() == ' ' || s.peek() == '\t' {
+		s.i++
+	}
+}
+
+func (s *tomlScanner) skipComment() {
+	if s.peek() == '#' {
+		for s.i < len(s.src) && s.src[s.i] != '\n' {
+			s.i++
+		}
+	}
+}
+
+// skipBlank Skips spaces, newlines and comments inside arrays
+func (s *tomlScanner) skipBlank() {
+	for {
+		s.skipSpaces()
+		s.skipComment()
+		if c := s.peek(); c != '\n' && c != '\r' {
+			return
+		}
+		s.i++
+	}
+}
+
+// endLine Expects the end of the line after an expression
+func (s *tomlScanner) endLine() error {
+	s.skipSpaces()
+	s.skipComment()
+	switch {
+	case s.peek() == '\n':
+		s.i++
+	case s.hasPrefix("\r\n"):
+		s.i += 2
+	case s.i < len(s.src):
+		return s.errorf("expected end of line, found " + strconv.QuoteRune(rune(s.peek())))
+	}
+	return nil
+}
+
+func (s *tomlScanner) document() error {
+	for s.i < len(s.src) {
+		s.skipSpaces()
+		switch c := s.peek(); {
+		case c == '\n' || c == '\r' || c == '#' || c == 0:
+		case c == '[':
+			s.i++
+			array := s.peek() == '['
+			if array {
+				s.i++
+			}
+			keys, err := s.keys()
+			if err != nil {
+				return err
+			}
+			if err := s.define(keys); err != nil {
+				return err
+			}
+			s.table = keys
+			if array {
+				_, _, id := joinKeys(keys)
+				n := strconv.Itoa(s.arrays[id])
+				s.table = appendKeys(keys, tomlKey{key: n, name: n, index: true})
+				s.arrays[id]++
+			}
+			closing := "]"
+			if array {
+				closing = "]]"
+			}
+			if !s.hasPrefix(closing) {
+				return s.errorf("expected " + closing)
+			}
+			s.i += len(closing)
+		default:
+			if err := s.keyValue(s.table); err != nil {
+				return err
+			}
+		}
+		if err := s.endLine(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// keyValue Key/value pair of the table with the key base
+func (s *tomlScanner) keyValue(base []tomlKey) error {
+	keys, err := s.keys()
+	if err != nil {
+		return err
+	}
+	keys = appendKeys(base, keys...)
+	if err := s.define(keys); err != nil {
+		return err
+	}
+	if s.peek() != '=' {
+		return s.errorf("expected =")
+	}
+	s.i++
+	return s.value(keys)
+}
+
+// keys Converts segments of the dotted key
+func (s *tomlScanner) keys() ([]tomlKey, error) {
+	var keys []tomlKey
+	for {
+		s.skipSpaces()
+		start := s.i
+		switch c := s.peek(); {
+		case c == '"' || c == '\'':
+			end, err := s.singleLineString(c)
+			if err != nil {
+				return nil, err
+			}
+			inner := string(s.src[start+1 : end-1])
+			name := inner
+			if c == '"' && strings.ContainsRune(inner, '\\') {
+				keys = append(keys, tomlKey{key: inner, name: name})
+				break
+			}
+			if n := s.convert(inner); n != "" && n != inner && !strings.ContainsRune(n, rune(c)) {
+				name = n
+				s.edits = append(s.edits, edit{start: start + 1, end: end - 1, text: name})
+			}
+			keys = append(keys, tomlKey{key: inner, name: name})
+		case isBareKeyChar(c):
+			for isBareKeyChar(s.peek()) {
+				s.i++
+			}
+			key := string(s.src[start:s.i])
+			name := key
+			if n := s.convert(key); n != "" && n != key {
+				name = n
+				if !isBareKey(n) {
+					n = strconv.Quote(n)
+				}
+				s.edits = append(s.edits, edit{start: start, end: s.i, text: n})
+			}
+			keys = append(keys, tomlKey{key: key, name: name})
+		default:
+			return nil, s.errorf("expected key")
+		}
+		s.skipSpaces()
+		if s.peek() != '.' {
+			return keys, nil
+		}
+		s.i++
+	}
+}
+
+// singleLineString Skips the basic or literal string starting at s.i, returns its end
+func (s *tomlScanner) singleLineString(quote byte) (int, error) {
+	for s.i++; ; s.i++ {
+		switch c := s.peek(); {
+		case c == 0 || c == '\n':
+			return 0, s.errorf("unterminated string")
+		case c == '\\' && quote == '"':
+			s.i++
+		case c == quote:
+			s.i++
+			return s.i, nil
+		}
+	}
+}
+
+// value Skips the value of the key, keys of inline tables are converted
+func (s *tomlScanner) value(key []tomlKey) error {
+	s.skipSpaces()
+	switch c := s.peek(); {
+	case s.hasPrefix(`"""`) || s.hasPrefix(`'''`):
+		delim := string(s.src[s.i : s.i+3])
+		s.i += 3
+		for {
+			if s.i >= len(s.src) {
+				return s.errorf("unterminated multi-line string")
+			}
+			if delim == `"""` && s.peek() == '\\' {
+				s.i += 2
+				continue
+			}
+			if s.hasPrefix(delim) {
+				s.i += 3
+				// Up to two quotes are allowed before the closing delimiter
+				for n := 0; n < 2 && s.peek() == delim[0]; n++ {
+					s.i++
+				}
+				return nil
+			}
+			s.i++
+		}
+	case c == '"' || c == '\'':
+		_, err := s.singleLineString(c)
+		return err
+	case c == '[':
+		s.i++
+		for n := 0; ; n++ {
+			s.skipBlank()
+			if s.peek() == ']' {
+				s.i++
+				return nil
+			}
+			if err := s.value(appendKeys(key, tomlKey{key: strconv.Itoa(n), name: strconv.Itoa(n), index: true})); err != nil {
+				return err
+			}
+			s.skipBlank()
+			switch s.peek() {
+			case ',':
+				s.i++
+			case ']':
+			default:
+				return s.errorf("expected , or ] in array")
+			}
+		}
+	case c == '{':
+		s.i++
+		s.skipSpaces()
+		if s.peek() == '}' {
+			s.i++
+			return nil
+		}
+		for {
+			if err := s.keyValue(key); err != nil {
+				return err
+			}
+			s.skipSpaces()
+			switch s.peek() {
+			case ',':
+				s.i++
+			case '}':
+				s.i++
+				return nil
+			default:
+				return s.errorf("expected , or } in inline table")
+			}
+		}
+	case c == 0 || c == '\n' || c == '\r' || c == '#':
+		return s.errorf("expected value")
+	default:
+		// Numbers, booleans and dates, local date-times may contain a space
+		for c := s.peek(); c != 0 && c != '\n' && c != '\r' && c != ',' && c != ']' && c != '}' && c != '#'; c = s.peek() {
+			s.i++
+		}
+		return nil
+	}
+}
+
+func isBareKeyChar(c byte) bool {
+	return 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '_' || c == '-'
+}
+
+func isBareKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if !isBareKeyChar(key[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,350 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configcase
+
+import (
+	"strconv"
+	"strings"
+)
+
+// YAML Rewrites keys of block and flow mappings of the YAML document.
+// Quoted keys keep their quotes, keys with escapes, complex keys ("? ") and keys with anchors or tags are kept.
+// Block scalars, multi-line quoted and plain scalars are skipped.
+// Returns ErrDuplicateKey if several keys of a mapping are converted to the same name.
+func YAML(src []byte, convert func(string) string) ([]byte, error) {
+	s := &yamlScanner{src: src, convert: convert, skipIndent: -1}
+	for start := 0; start < len(src); {
+		end := start
+		for end < len(src) && src[end] != '\n' {
+			end++
+		}
+		if err := s.line(start, end); err != nil {
+			return nil, err
+		}
+		start = end + 1
+	}
+	if s.quote != 0 {
+		return nil, syntaxError("yaml", lineOf(src, len(src)), "unterminated quoted scalar")
+	}
+	if len(s.flow) > 0 {
+		return nil, syntaxError("yaml", lineOf(src, len(src)), "unterminated flow collection")
+	}
+	return applyEdits(src, s.edits), nil
+}
+
+type yamlScanner struct {
+	src     []byte
+	convert func(string) string
+	edits   []edit
+
+	// skipIndent Lines indented more are content of a block scalar or a multi-line plain scalar, -1 if none
+	skipIndent int
+	// quote Quote of a multi-line quoted scalar
+	quote byte
+	// flow Open flow collections, '{' or '['
+	flow []byte
+	// expectKey Next token of the flow mapping is a key
+	expectKey bool
+	// flowKeys Keys of the open flow collections, nil for sequences
+	flowKeys []keySet
+	// block Open block mappings, innermost last
+	block []yamlMapping
+}
+
+// yamlMapping Block mapping by the indent of its keys
+type yamlMapping struct {
+	indent int
+	keys   keySet
+}
+
+// blockKeys Keys of the block mapping of a key at indent, entry if the key starts a sequence entry
+func (s *yamlScanner) blockKeys(indent int, entry bool) keySet {
+	for len(s.block) > 0 {
+		top := s.block[len(s.block)-1]
+		if top.indent < indent || top.indent == indent && !entry {
+			break
+		}
+		s.block = s.block[:len(s.block)-1]
+	}
+	if len(s.block) == 0 || s.block[len(s.block)-1].indent != indent {
+		s.block = append(s.block, yamlMapping{indent: indent, keys: keySet{}})
+	}
+	return s.block[len(s.block)-1].keys
+}
+
+// addKey Records the key of the mapping, name is empty for keys that are not mapping keys
+func (s *yamlScanner) addKey(keys keySet, start int, key, name string) error {
+	if name == "" {
+		return nil
+	}
+	if prev, dup := keys.add(key, name); dup {
+		return duplicateKeyError("yaml", lineOf(s.src, start), prev, key, name)
+	}
+	return nil
+}
+
+func (s *yamlScanner) line(start, end int) error {
+	line := string(s.src[start:end])
+	line = strings.TrimSuffix(line, "\r")
+	indent := len(line) - len(strings.TrimLeft(line, " "))
+	blank := strings.TrimSpace(line) == ""
+
+	if s.skipIndent >= 0 {
+		if blank || indent > s.skipIndent {
+			return nil
+		}
+		s.skipIndent = -1
+	}
+	if s.quote != 0 || len(s.flow) > 0 {
+		_, err := s.value(start, line, 0)
+		return err
+	}
+	trimmed := line[indent:]
+	if blank || trimmed[0] == '#' || trimmed[0] == '%' && indent == 0 {
+		return nil
+	}
+	if indent == 0 && (isMarker(line, "---") || isMarker(line, "...")) {
+		s.block = nil
+		return nil
+	}
+
+	// Sequence entries "- - key: value"
+	pos, keyIndent := indent, indent
+	for pos < len(line) && line[pos] == '-' && (pos+1 == len(line) || line[pos+1] == ' ') {
+		pos++
+		for pos < len(line) && line[pos] == ' ' {
+			pos++
+		}
+		keyIndent = pos
+	}
+	if pos == len(line) || line[pos] == '?' {
+		return nil
+	}
+
+	hasKey := false
+	if colon, key, name, ok := s.key(start, line, pos, false); ok {
+		if err := s.addKey(s.blockKeys(pos, pos > indent), start, key, name); err != nil {
+			return err
+		}
+		pos, hasKey = colon+1, true
+	}
+	v, err := s.value(start, line, pos)
+	if err != nil {
+		return err
+	}
+	if s.quote != 0 || len(s.flow) > 0 {
+		return nil
+	}
+	// Content of block scalars and continuation lines of plain scalars are indented more than the key or the entry
+	if v != "" && (v[0] == '|' || v[0] == '>' || strings.IndexByte("\"'{[*", v[0]) < 0) {
+		if hasKey {
+			s.skipIndent = keyIndent
+		} else {
+			s.skipIndent = indent
+		}
+	}
+	return nil
+}
+
+// key Converts the key at pos if the line has one, returns the position of the ':', the key and its new name,
+// the name is empty for the merge key "<<".
+// In flow mappings keys end at ":" before a space, a flow indicator or the end of the line
+func (s *yamlScanner) key(start int, line string, pos int, flow bool) (int, string, string, bool) {
+	if pos >= len(line) {
+		return 0, "", "", false
+	}
+	var inner, innerStart, colon int = 0, pos, 0
+	switch c := line[pos]; {
+	case c == '"' || c == '\'':
+		end := closingQuote(line, pos+1, c)
+		if end < 0 {
+			return 0, "", "", false
+		}
+		innerStart, inner = pos+1, end
+		colon = end + 1
+		for colon < len(line) && line[colon] == ' ' {
+			colon++
+		}
+		if colon == len(line) || line[colon] != ':' || !isKeyEnd(line, colon+1, flow) {
+			return 0, "", "", false
+		}
+		text := line[innerStart:inner]
+		if c == '"' && strings.ContainsRune(text, '\\') || c == '\'' && strings.Contains(text, "''") {
+			return colon, text, text, true
+		}
+		name := s.convert(text)
+		if name == "" || name == text || strings.ContainsRune(name, rune(c)) {
+			return colon, text, text, true
+		}
+		s.edits = append(s.edits, edit{start: start + innerStart, end: start + inner, text: name})
+		return colon, text, name, true
+	case strings.IndexByte("&*!|>%@`#,[]{}", c) >= 0:
+		return 0, "", "", false
+	}
+
+	for colon = pos; colon < len(line); colon++ {
+		c := line[colon]
+		if c == ':' && isKeyEnd(line, colon+1, flow) {
+			break
+		}
+		if c == '#' && line[colon-1] == ' ' || flow && strings.IndexByte(",[]{}", c) >= 0 {
+			return 0, "", "", false
+		}
+	}
+	if colon == len(line) {
+		return 0, "", "", false
+	}
+	end := colon
+	for end > pos && line[end-1] == ' ' {
+		end--
+	}
+	text := line[pos:end]
+	// "<<" Merge key
+	if text == "<<" {
+		return colon, text, "", true
+	}
+	name := s.convert(text)
+	if name == "" || name == text {
+		return colon, text, text, true
+	}
+	quoted := name
+	if needsQuotes(name) {
+		quoted = strconv.Quote(name)
+	}
+	s.edits = append(s.edits, edit{start: start + pos, end: start + end, text: quoted})
+	return colon, text, name, true
+}
+
+// value Skips the value from pos, tracks quotes and flow collections and converts keys of flow mappings.
+// Returns the value of a block line without the comment
+func (s *yamlScanner) value(start int, line string, pos int) (string, error) {
+	valueStart := -1
+	i := pos
+	for i < len(line) {
+		c := line[i]
+		if s.quote != 0 {
+			end := closingQuote(line, i, s.quote)
+			if end < 0 {
+				return "", nil
+			}
+			s.quote = 0
+			i = end + 1
+			continue
+		}
+		if c == ' ' || c == '\t' || c == '\r' {
+			i++
+			continue
+		}
+		if c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t') {
+			break
+		}
+		if valueStart < 0 && len(s.flow) == 0 {
+			valueStart = i
+			// Anchors and tags before a nested block
+			if c == '&' || c == '!' {
+				for i < len(line) && line[i] != ' ' {
+					i++
+				}
+				valueStart = -1
+				continue
+			}
+		}
+		if s.expectKey && len(s.flow) > 0 && s.flow[len(s.flow)-1] == '{' {
+			s.expectKey = false
+			if colon, key, name, ok := s.key(start, line, i, true); ok {
+				if err := s.addKey(s.flowKeys[len(s.flowKeys)-1], start, key, name); err != nil {
+					return "", err
+				}
+				i = colon + 1
+				continue
+			}
+		}
+		switch c {
+		case '"', '\'':
+			s.quote = c
+			i++
+		case '{', '[':
+			s.flow = append(s.flow, c)
+			var keys keySet
+			if c == '{' {
+				keys = keySet{}
+			}
+			s.flowKeys = append(s.flowKeys, keys)
+			s.expectKey = c == '{'
+			i++
+		case '}', ']':
+			if len(s.flow) == 0 {
+				return "", syntaxError("yaml", lineOf(s.src, start), "unexpected "+string(c))
+			}
+			s.flow = s.flow[:len(s.flow)-1]
+			s.flowKeys = s.flowKeys[:len(s.flowKeys)-1]
+			i++
+		case ',':
+			s.expectKey = len(s.flow) > 0 && s.flow[len(s.flow)-1] == '{'
+			i++
+		default:
+			if len(s.flow) == 0 {
+				// Plain scalar, a ' #' starts the comment
+				for i < len(line) && !(line[i] == '#' && (line[i-1] == ' ' || line[i-1] == '\t')) {
+					i++
+				}
+				continue
+			}
+			for i < len(line) && strings.IndexByte(",[]{}#", line[i]) < 0 {
+				i++
+			}
+			if i < len(line) && line[i] == '#' && line[i-1] != ' ' {
+				i++
+			}
+		}
+	}
+	if valueStart < 0 {
+		return "", nil
+	}
+	return strings.TrimSpace(strings.SplitN(line[valueStart:i], " #", 2)[0]), nil
+}
+
+// closingQuote Index of the closing quote from i, -1 if the scalar continues on the next line
+func closingQuote(line string, i int, quote byte) int {
+	for ; i < len(line); i++ {
+		switch {
+		case quote == '"' && line[i] == '\\':
+			i++
+		case quote == '\'' && line[i] == '\'' && i+1 < len(line) && line[i+1] == '\'':
+			i++
+		case line[i] == quote:
+			return i
+		}
+	}
+	return -1
+}
+
+// isKeyEnd ":" at i-1 ends a key: followed by a space or the end of the line, in flow mappings also by a flow indicator
+func isKeyEnd(line string, i int, flow bool) bool {
+	return i == len(line) || line[i] == ' ' || line[i] == '\t' || line[i] == '\r' ||
+		flow && strings.IndexByte(",[]{}", line[i]) >= 0
+}
+
+func isMarker(line, marker string) bool {
+	return strings.HasPrefix(line, marker) && (len(line) == len(marker) || line[len(marker)] == ' ')
+}
+
+// needsQuotes Plain key is not valid
+func needsQuotes(key string) bool {
+	return key == "" || strings.IndexByte("&*!|>%@`#,[]{}\"'-?: ", key[0]) >= 0 ||
+		strings.Contains(key, ": ") || strings.Contains(key, " #") || strings.HasSuffix(key, " ")
+}
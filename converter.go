@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Converter Case conversions bound to its own acronym dictionary, independent of the global one set by SetAcronyms
+type Converter struct {
+	acronyms           *sync.Map
+	spellLeadingDigits int32
+	fullCaseMapping    int32
+	transliteration    int32
+}
+
+// NewConverter Creates a Converter with acronyms in the SetAcronyms format. Ex. NewConverter(map[string][]string{"ID": {"id", "Id"}})
+func NewConverter(acronyms map[string][]string) *Converter {
+	c := &Converter{acronyms: &sync.Map{}}
+	for acr, variants := range acronyms {
+		loadAcronym(c.acronyms, acr, variants...)
+	}
+	return c
+}
+
+// AddAcronym Adds acronym to the Converter dictionary
+func (c *Converter) AddAcronym(acr string, variants ...string) {
+	loadAcronym(c.acronyms, acr, variants...)
+}
+
+// SetSpellLeadingDigits Enable or disable spelling out of leading digits of the first word.
+// When enabled, conversions never start with a digit. Ex. ToPascalCase("3d model") -> ThreeDModel, ToSnakeCase("2nd place") -> second_place
+// Disabled by default.
+func (c *Converter) SetSpellLeadingDigits(enable bool) {
+	var v int32
+	if enable {
+		v = 1
+	}
+	atomic.StoreInt32(&c.spellLeadingDigits, v)
+}
+
+// SetFullCaseMapping Enable or disable full case mapping of capitalized words.
+// When enabled, the first rune of a word may expand to several runes. Ex. ToPascalCase("ßtraße") -> Sstraße, ToPascalCase("ﬁle") -> File
+// Disabled by default: the first rune is mapped with unicode.ToTitle only.
+func (c *Converter) SetFullCaseMapping(enable bool) {
+	var v int32
+	if enable {
+		v = 1
+	}
+	atomic.StoreInt32(&c.fullCaseMapping, v)
+}
+
+// toTitleRunes Capitalizes the word with the full case mapping if enabled
+func (c *Converter) toTitleRunes(rs []rune) []rune {
+	if atomic.LoadInt32(&c.fullCaseMapping) != 0 {
+		return toFullTitleRunes(rs)
+	}
+	return toTitleRunes(rs)
+}
+
+func (c *Converter) titleWord(_ int, word []rune) []rune {
+	return c.toTitleRunes(word)
+}
+
+func (c *Converter) sentenceWord(i int, word []rune) []rune {
+	if i == 0 {
+		return c.toTitleRunes(word)
+	}
+	return word
+}
+
+// SetTransliteration Enable or disable ASCII transliteration of the input before it is split into words.
+// When enabled, conversions fold the input with TransliterateRunes. Ex. ToSnakeCase("Größe des Kontos") -> grosse_des_kontos
+// Disabled by default.
+func (c *Converter) SetTransliteration(enable bool) {
+	var v int32
+	if enable {
+		v = 1
+	}
+	atomic.StoreInt32(&c.transliteration, v)
+}
+
+// parseRunes Words of str, transliterated and with leading digits spelled out if enabled
+func (c *Converter) parseRunes(str string) [][]rune {
+	rs := []rune(str)
+	if atomic.LoadInt32(&c.transliteration) != 0 {
+		rs = TransliterateRunes(rs)
+	}
+	words := ParseRunes(rs)
+	if atomic.LoadInt32(&c.spellLeadingDigits) != 0 {
+		words = spellLeadingDigitsWords(words)
+	}
+	return words
+}
+
+// ReplaceAcronym Replaces the word with the acronym from the Converter dictionary
+func (c *Converter) ReplaceAcronym(word string) (string, bool) {
+	rW, found := replaceAcronymRunes(c.acronyms, []rune(word), false)
+	return string(rW), found
+}
+
+// ToMergeCase Replace acronym in string. Ex. mergecaseID
+func (c *Converter) ToMergeCase(str string) string {
+	return string(concatRuneWords(replaceAcronymsRunes(c.acronyms, c.parseRunes(str)), nil))
+}
+
+// ToSnakeCase Replace acronym in string. Ex. snake_case_ID
+func (c *Converter) ToSnakeCase(str string) string {
+	return string(concatRuneWords(replaceAcronymsRunes(c.acronyms, c.parseRunes(str)), []rune{SeparatorUnderscore}))
+}
+
+// ToKebabCase Replace acronym in string. Ex. kebab-case-ID
+func (c *Converter) ToKebabCase(str string) string {
+	return string(concatRuneWords(replaceAcronymsRunes(c.acronyms, c.parseRunes(str)), []rune{SeparatorDash}))
+}
+
+// ToDotCase Replace acronym in string. Ex. dot.case.ID
+func (c *Converter) ToDotCase(str string) string {
+	return string(concatRuneWords(replaceAcronymsRunes(c.acronyms, c.parseRunes(str)), []rune{SeparatorDot}))
+}
+
+// ToCamelCase Replace acronym in string. Ex. camelCaseID
+func (c *Converter) ToCamelCase(str string) string {
+	return string(camelCase(c.parseRunes(str), false, c.acronyms, c.toTitleRunes))
+}
+
+// ToPascalCase Replace acronym in string. Ex. PascalCaseID
+func (c *Converter) ToPascalCase(str string) string {
+	return string(camelCase(c.parseRunes(str), true, c.acronyms, c.toTitleRunes))
+}
+
+// ToScreamingSnakeCase Replace acronym in string. Ex. SCREAMING_SNAKE_CASE_IDs
+func (c *Converter) ToScreamingSnakeCase(str string) string {
+	return string(wordsCase(c.parseRunes(str), []rune{SeparatorUnderscore}, c.acronyms, upperWord))
+}
+
+// ToTitleCase Replace acronym in string. Ex. Title Case ID
+func (c *Converter) ToTitleCase(str string) string {
+	return string(wordsCase(c.parseRunes(str), []rune{' '}, c.acronyms, c.titleWord))
+}
+
+// ToTrainCase Replace acronym in string. Ex. Train-Case-ID
+func (c *Converter) ToTrainCase(str string) string {
+	return string(wordsCase(c.parseRunes(str), []rune{SeparatorDash}, c.acronyms, c.titleWord))
+}
+
+// ToSentenceCase Replace acronym in string. Ex. Sentence case ID
+func (c *Converter) ToSentenceCase(str string) string {
+	return string(wordsCase(c.parseRunes(str), []rune{' '}, c.acronyms, c.sentenceWord))
+}
@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+// DetectCase Detects case of the string, acronyms are allowed. Ex. "field_name" -> snake, "fieldID" -> camel
+// A single lowercase word is detected as merge, mergecase with acronyms is detected as camel.
+func DetectCase(str string) string {
+	if str == "" {
+		return CaseUnknown
+	}
+	for _, c := range cases {
+		if c.f(str) == str {
+			return c.name
+		}
+	}
+	for _, c := range cases {
+		if c.name != CaseMerge && c.fAcr(str) == str {
+			return c.name
+		}
+	}
+	return CaseUnknown
+}
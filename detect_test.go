@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"testing"
+)
+
+func TestDetectCase(t *testing.T) {
+	tests := []struct {
+		str  string
+		want string
+	}{
+		{str: "", want: CaseUnknown},
+		{str: "field", want: CaseMerge},
+		{str: "fieldname", want: CaseMerge},
+		{str: "field_name", want: CaseSnake},
+		{str: "field_ID", want: CaseSnake},
+		{str: "FIELD_NAME", want: CaseScreamingSnake},
+		{str: "FIELD", want: CaseScreamingSnake},
+		{str: "field-name", want: CaseKebab},
+		{str: "field.name", want: CaseDot},
+		{str: "fieldName", want: CaseCamel},
+		{str: "fieldID", want: CaseCamel},
+		{str: "FieldName", want: CasePascal},
+		{str: "FieldID", want: CasePascal},
+		{str: "Field-Name", want: CaseTrain},
+		{str: "Field Name", want: CaseTitle},
+		{str: "Field name", want: CaseSentence},
+		{str: "Field_name", want: CaseUnknown},
+		{str: "field name", want: CaseUnknown},
+		{str: "field__name", want: CaseUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.str, func(t *testing.T) {
+			if got := DetectCase(tt.str); got != tt.want {
+				t.Errorf("DetectCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
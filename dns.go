@@ -0,0 +1,188 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Length limits of RFC 1123 names and Kubernetes label keys
+const (
+	DNSLabelMaxLen     = 63
+	DNSSubdomainMaxLen = 253
+	K8sLabelNameMaxLen = 63
+)
+
+// ErrInvalidDNSName Name is not a valid DNS label, subdomain or label key
+var ErrInvalidDNSName = errors.New("strcase: invalid name")
+
+// ToDNSLabel RFC 1123 label: lower case alphanumerics and "-", at most 63 characters. Ex. "My App_v2" -> "my-app-v2"
+//
+// The input is folded to ASCII, other invalid runes are removed. The result is empty if no valid rune remains.
+func ToDNSLabel(str string) string {
+	return dnsLabel(str, DNSLabelMaxLen)
+}
+
+// ToDNSSubdomain RFC 1123 subdomain: labels joined by ".", at most 253 characters. Ex. "API.Example.com" -> "api.example.com"
+func ToDNSSubdomain(str string) string {
+	labels := make([]string, 0, strings.Count(str, ".")+1)
+	for _, part := range strings.Split(str, ".") {
+		if label := dnsLabel(part, DNSSubdomainMaxLen); label != "" {
+			labels = append(labels, label)
+		}
+	}
+	name := strings.Join(labels, ".")
+	if len(name) > DNSSubdomainMaxLen {
+		name = strings.TrimRight(name[:DNSSubdomainMaxLen], "-.")
+	}
+	return name
+}
+
+// ToK8sLabelKey Kubernetes label key: optional DNS subdomain prefix and "/", name of at most 63 characters.
+// The result is empty if the name has no valid rune.
+// Ex. "App.Kubernetes.io/ManagedBy" -> "app.kubernetes.io/managed-by"
+func ToK8sLabelKey(str string) string {
+	i := strings.IndexByte(str, '/')
+	if i < 0 {
+		return dnsLabel(str, K8sLabelNameMaxLen)
+	}
+	prefix, name := ToDNSSubdomain(str[:i]), dnsLabel(str[i+1:], K8sLabelNameMaxLen)
+	if prefix == "" || name == "" {
+		return name
+	}
+	return prefix + "/" + name
+}
+
+// dnsLabel Kebab case of str with runes other than [a-z0-9-] removed, truncated to max
+func dnsLabel(str string, max int) string {
+	kebab := ToKebabCase(Transliterate(str))
+	b := make([]byte, 0, len(kebab))
+	for i := 0; i < len(kebab); i++ {
+		c := kebab[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+		case c >= 'A' && c <= 'Z':
+			c += 'a' - 'A'
+		case c == '-':
+			if len(b) == 0 || b[len(b)-1] == '-' {
+				continue
+			}
+		default:
+			continue
+		}
+		b = append(b, c)
+	}
+	if len(b) > max {
+		b = b[:max]
+	}
+	return strings.TrimRight(string(b), "-")
+}
+
+// ValidateDNSLabel Checks str is an RFC 1123 label, the error describes the first violation
+func ValidateDNSLabel(str string) error {
+	if err := validateDNSLabel(str, DNSLabelMaxLen); err != nil {
+		return fmt.Errorf("%w: DNS label %q %s", ErrInvalidDNSName, str, err)
+	}
+	return nil
+}
+
+// ValidateDNSSubdomain Checks str is an RFC 1123 subdomain, the error describes the first violation
+func ValidateDNSSubdomain(str string) error {
+	if err := validateDNSSubdomain(str); err != nil {
+		return fmt.Errorf("%w: DNS subdomain %q %s", ErrInvalidDNSName, str, err)
+	}
+	return nil
+}
+
+// ValidateK8sLabelKey Checks str is a Kubernetes label key, the error describes the first violation
+func ValidateK8sLabelKey(str string) error {
+	name := str
+	if i := strings.IndexByte(str, '/'); i >= 0 {
+		prefix := str[:i]
+		name = str[i+1:]
+		if prefix == "" {
+			return fmt.Errorf("%w: label key %q: prefix must not be empty", ErrInvalidDNSName, str)
+		}
+		if err := validateDNSSubdomain(prefix); err != nil {
+			return fmt.Errorf("%w: label key %q: prefix %s", ErrInvalidDNSName, str, err)
+		}
+	}
+	if err := validateLabelName(name); err != nil {
+		return fmt.Errorf("%w: label key %q: name %s", ErrInvalidDNSName, str, err)
+	}
+	return nil
+}
+
+func validateDNSLabel(str string, max int) error {
+	switch {
+	case str == "":
+		return errors.New("must not be empty")
+	case len(str) > max:
+		return fmt.Errorf("must be no more than %d characters", max)
+	case !isDNSAlnum(str[0]):
+		return errors.New("must start with a lower case alphanumeric character")
+	case !isDNSAlnum(str[len(str)-1]):
+		return errors.New("must end with a lower case alphanumeric character")
+	}
+	for i := 0; i < len(str); i++ {
+		if !isDNSAlnum(str[i]) && str[i] != '-' {
+			return fmt.Errorf("must consist of lower case alphanumeric characters or '-', got %q at %d", str[i], i)
+		}
+	}
+	return nil
+}
+
+func validateDNSSubdomain(str string) error {
+	if len(str) > DNSSubdomainMaxLen {
+		return fmt.Errorf("must be no more than %d characters", DNSSubdomainMaxLen)
+	}
+	for _, label := range strings.Split(str, ".") {
+		if err := validateDNSLabel(label, DNSSubdomainMaxLen); err != nil {
+			return fmt.Errorf("label %q %s", label, err)
+		}
+	}
+	return nil
+}
+
+func validateLabelName(str string) error {
+	switch {
+	case str == "":
+		return errors.New("must not be empty")
+	case len(str) > K8sLabelNameMaxLen:
+		return fmt.Errorf("must be no more than %d characters", K8sLabelNameMaxLen)
+	case !isLabelAlnum(str[0]):
+		return errors.New("must start with an alphanumeric character")
+	case !isLabelAlnum(str[len(str)-1]):
+		return errors.New("must end with an alphanumeric character")
+	}
+	for i := 0; i < len(str); i++ {
+		if c := str[i]; !isLabelAlnum(c) && c != '-' && c != '_' && c != '.' {
+			return fmt.Errorf("must consist of alphanumeric characters, '-', '_' or '.', got %q at %d", c, i)
+		}
+	}
+	return nil
+}
+
+func isDNSAlnum(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= '0' && c <= '9'
+}
+
+func isLabelAlnum(c byte) bool {
+	return isDNSAlnum(c) || c >= 'A' && c <= 'Z'
+}
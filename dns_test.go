@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// Regexes of k8s.io/apimachinery/pkg/util/validation
+var (
+	dns1123LabelRegexp     = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	dns1123SubdomainRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+	qualifiedNameRegexp    = regexp.MustCompile(`^([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]$`)
+)
+
+func isDNSLabel(s string) bool {
+	return len(s) <= DNSLabelMaxLen && dns1123LabelRegexp.MatchString(s)
+}
+
+func isDNSSubdomain(s string) bool {
+	return len(s) <= DNSSubdomainMaxLen && dns1123SubdomainRegexp.MatchString(s)
+}
+
+func isK8sLabelKey(s string) bool {
+	name := s
+	if i := strings.IndexByte(s, '/'); i >= 0 {
+		if !isDNSSubdomain(s[:i]) {
+			return false
+		}
+		name = s[i+1:]
+	}
+	return len(name) <= K8sLabelNameMaxLen && qualifiedNameRegexp.MatchString(name)
+}
+
+var dnsInputs = []string{
+	"My App_v2",
+	"API.Example.com",
+	"App.Kubernetes.io/ManagedBy",
+	"Größe des Kontos",
+	"--leading and trailing--",
+	"..a..b..",
+	"/name",
+	"a//b",
+	"example.com/",
+	"userID",
+	strings.Repeat("VeryLongName", 30),
+	strings.Repeat("segment.", 40) + "end/" + strings.Repeat("Name", 20),
+}
+
+func TestToDNSLabel(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"My App_v2", "my-app-v2"},
+		{"API.Example.com", "api-example-com"},
+		{"Größe des Kontos", "grosse-des-kontos"},
+		{"--leading and trailing--", "leading-and-trailing"},
+		{"用户", ""},
+		{strings.Repeat("a-", 40), strings.TrimRight(strings.Repeat("a-", 32), "-")},
+	}
+	for _, tt := range tests {
+		if got := ToDNSLabel(tt.in); got != tt.want {
+			t.Errorf("ToDNSLabel(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+	for _, in := range dnsInputs {
+		if got := ToDNSLabel(in); !isDNSLabel(got) {
+			t.Errorf("ToDNSLabel(%q) = %q, does not match RFC 1123 label", in, got)
+		}
+	}
+}
+
+func TestToDNSSubdomain(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"API.Example.com", "api.example.com"},
+		{"..a..b..", "a.b"},
+		{"My Service.Prod", "my-service.prod"},
+	}
+	for _, tt := range tests {
+		if got := ToDNSSubdomain(tt.in); got != tt.want {
+			t.Errorf("ToDNSSubdomain(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+	for _, in := range dnsInputs {
+		if got := ToDNSSubdomain(in); !isDNSSubdomain(got) {
+			t.Errorf("ToDNSSubdomain(%q) = %q, does not match RFC 1123 subdomain", in, got)
+		}
+	}
+}
+
+func TestToK8sLabelKey(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"App.Kubernetes.io/ManagedBy", "app.kubernetes.io/managed-by"},
+		{"ManagedBy", "managed-by"},
+		{"/name", "name"},
+		{"Example.com/Part Of", "example.com/part-of"},
+		{"example.com/", ""},
+		{"example.com/用户", ""},
+	}
+	for _, tt := range tests {
+		if got := ToK8sLabelKey(tt.in); got != tt.want {
+			t.Errorf("ToK8sLabelKey(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+	for _, in := range dnsInputs {
+		if got := ToK8sLabelKey(in); got != "" && !isK8sLabelKey(got) {
+			t.Errorf("ToK8sLabelKey(%q) = %q, does not match label key", in, got)
+		}
+	}
+}
+
+func TestValidateDNS(t *testing.T) {
+	tests := []string{
+		"", "a", "my-app", "-a", "a-", "A", "a_b", "a.b", "a..b", ".a", "a.b-", "0", "a/b", "a/-b", "/b",
+		"example.com/Name_1.x", "Example.com/name", "a/b/c", "a/",
+		strings.Repeat("a", 63), strings.Repeat("a", 64),
+		strings.Repeat("a.", 126) + "a", strings.Repeat("a.", 127) + "a",
+		strings.Repeat("a", 64) + ".com/name",
+	}
+	for _, s := range tests {
+		if err := ValidateDNSLabel(s); (err == nil) != isDNSLabel(s) || err != nil && !errors.Is(err, ErrInvalidDNSName) {
+			t.Errorf("ValidateDNSLabel(%q) = %v, regex %v", s, err, isDNSLabel(s))
+		}
+		if err := ValidateDNSSubdomain(s); (err == nil) != isDNSSubdomain(s) {
+			t.Errorf("ValidateDNSSubdomain(%q) = %v, regex %v", s, err, isDNSSubdomain(s))
+		}
+		if err := ValidateK8sLabelKey(s); (err == nil) != isK8sLabelKey(s) {
+			t.Errorf("ValidateK8sLabelKey(%q) = %v, regex %v", s, err, isK8sLabelKey(s))
+		}
+	}
+
+	err := ValidateDNSLabel("ab_c")
+	if want := `DNS label "ab_c" must consist of lower case alphanumeric characters or '-', got '_' at 2`; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("ValidateDNSLabel() = %v, want %v", err, want)
+	}
+}
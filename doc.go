@@ -32,4 +32,14 @@
 //	| ToDotCaseRunes(rs)              | field.name               |
 //	| ParseString(s)                  | []string{"field","name"} |
 //	| ParseRunes(rs)                  | [][]rune{"field","name"} |
+//
+// # Caseless scripts
+//
+// Words of scripts without case (Han, Kana, Hangul, Arabic, Hebrew, Thai, ...) are split
+// only by separators and by a change of script, ex. "用户ID" -> "用户","id" and "ユーザーName" -> "ユーザー","name".
+// Digits, combining marks and other runes without a script of their own stay in the current word.
+//
+// In camelCase and PascalCase caseless words are kept as is and joined without a separator,
+// ex. ToCamelCase("ID 用户 名称") -> "id用户名称", so the boundary between two adjacent caseless words
+// is not preserved. Use snake_case, kebab-case or dot.case when it must survive a round trip.
 package strcase
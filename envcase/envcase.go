@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package envcase binds environment variables to struct fields by SCREAMING_SNAKE_CASE of field paths.
+//
+//	type Config struct {
+//		HTTPPort int                 // APP_HTTP_PORT
+//		Database struct {
+//			MaxOpenConns int         // APP_DATABASE_MAX_OPEN_CONNS
+//			Timeout time.Duration    // APP_DATABASE_TIMEOUT
+//		}
+//		Hosts []string `env:"HOSTS"` // APP_HOSTS, comma separated
+//	}
+//
+//	cfg := Config{HTTPPort: 8080}
+//	err := envcase.Load("APP", &cfg)
+package envcase
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/nikitaksv/strcase"
+	"github.com/nikitaksv/strcase/internal/fieldvalue"
+)
+
+// DefaultTag Tag with variable name overrides of fields
+const DefaultTag = "env"
+
+// Separator Separator of prefix and field path names
+const Separator = "_"
+
+// Var Environment variable of the struct field
+type Var struct {
+	// Name Variable name. Ex. APP_DATABASE_MAX_OPEN_CONNS
+	Name string
+	// Field Field path. Ex. Database.MaxOpenConns
+	Field string
+	// Type Go type of the field. Ex. int
+	Type string
+	// Value Current value of the field in the variable format
+	Value string
+
+	index []int
+}
+
+// Binder Binds environment variables to struct fields
+type Binder struct {
+	prefix  string
+	convert func(string) string
+	tag     string
+}
+
+// New Binder with the prefix of variable names and the converter of field names. Ex. New("APP", strcase.ToScreamingSnakeCase)
+func New(prefix string, convert func(string) string) *Binder {
+	return NewWithTag(prefix, convert, DefaultTag)
+}
+
+// NewWithTag Binder with the prefix, the converter of field names and the tag overrides
+func NewWithTag(prefix string, convert func(string) string, tag string) *Binder {
+	return &Binder{prefix: prefix, convert: convert, tag: tag}
+}
+
+// Vars Variables of the struct fields, dest is a struct or a pointer to struct.
+// Nested structs add their name to the path, embedded structs without tag are flattened,
+// fields with tag "-" and unexported fields are skipped. Tag replaces the name of the field in the path.
+func (b *Binder) Vars(dest interface{}) ([]Var, error) {
+	v := reflect.Indirect(reflect.ValueOf(dest))
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("envcase: dest must be a struct or a pointer to struct, got %T", dest)
+	}
+	var vars []Var
+	if err := b.vars(v, b.prefix, "", nil, &vars); err != nil {
+		return nil, err
+	}
+	return vars, nil
+}
+
+func (b *Binder) vars(v reflect.Value, name, field string, index []int, vars *[]Var) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, hasTag := f.Tag.Lookup(b.tag)
+		fName := strings.SplitN(tag, ",", 2)[0]
+		if fName == "-" {
+			continue
+		}
+		fv := v.Field(i)
+		fIndex := append(append(make([]int, 0, len(index)+1), index...), i)
+		if f.Anonymous && !hasTag && fieldvalue.IsNested(f.Type) {
+			if err := b.vars(fv, name, field, fIndex, vars); err != nil {
+				return err
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if fName == "" {
+			fName = b.convert(f.Name)
+		}
+		fName = join(name, fName, Separator)
+		fField := join(field, f.Name, ".")
+		if fieldvalue.IsNested(f.Type) {
+			if err := b.vars(fv, fName, fField, fIndex, vars); err != nil {
+				return err
+			}
+			continue
+		}
+		if !fieldvalue.IsSupported(f.Type) {
+			return fmt.Errorf("envcase: unsupported type %s of field %s", f.Type, fField)
+		}
+		value, err := fieldvalue.Format(fv)
+		if err != nil {
+			return fmt.Errorf("envcase: %s: %w", fName, err)
+		}
+		*vars = append(*vars, Var{Name: fName, Field: fField, Type: f.Type.String(), Value: value, index: fIndex})
+	}
+	return nil
+}
+
+// Load Sets fields of dest, a pointer to struct, from the environment. Unset variables keep the field values
+func (b *Binder) Load(dest interface{}) error {
+	return b.LoadFunc(dest, os.LookupEnv)
+}
+
+// LoadFunc Sets fields of dest, a pointer to struct, from the lookup. Ex. LoadFunc(&cfg, os.LookupEnv)
+func (b *Binder) LoadFunc(dest interface{}, lookup func(string) (string, bool)) error {
+	v := reflect.ValueOf(dest)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("envcase: dest must be a non-nil pointer to struct, got %T", dest)
+	}
+	vars, err := b.Vars(dest)
+	if err != nil {
+		return err
+	}
+	for _, vr := range vars {
+		s, ok := lookup(vr.Name)
+		if !ok {
+			continue
+		}
+		if err := fieldvalue.Parse(v.Elem().FieldByIndex(vr.index), s); err != nil {
+			return fmt.Errorf("envcase: %s: %w", vr.Name, err)
+		}
+	}
+	return nil
+}
+
+// Print Writes variables of dest in the .env format with the current values. Ex. APP_HTTP_PORT=8080
+func (b *Binder) Print(w io.Writer, dest interface{}) error {
+	vars, err := b.Vars(dest)
+	if err != nil {
+		return err
+	}
+	for _, vr := range vars {
+		if _, err := fmt.Fprintf(w, "%s=%s\n", vr.Name, vr.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Vars Variables of the struct fields by SCREAMING_SNAKE_CASE with the prefix
+func Vars(prefix string, dest interface{}) ([]Var, error) {
+	return New(prefix, strcase.ToScreamingSnakeCase).Vars(dest)
+}
+
+// Load Sets fields of dest from the environment by SCREAMING_SNAKE_CASE with the prefix
+func Load(prefix string, dest interface{}) error {
+	return New(prefix, strcase.ToScreamingSnakeCase).Load(dest)
+}
+
+// Print Writes variables of dest by SCREAMING_SNAKE_CASE with the prefix
+func Print(w io.Writer, prefix string, dest interface{}) error {
+	return New(prefix, strcase.ToScreamingSnakeCase).Print(w, dest)
+}
+
+func join(prefix, name, sep string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + sep + name
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package envcase
+
+import (
+	"bytes"
+	"net"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nikitaksv/strcase"
+)
+
+type Database struct {
+	MaxOpenConns int
+	Timeout      time.Duration
+}
+
+type Common struct {
+	Debug bool
+}
+
+type Config struct {
+	Common
+	HTTPPort   uint16
+	APIKey     string
+	Ratio      float64
+	Database   Database
+	Hosts      []string `env:"HOSTS"`
+	Ports      []int
+	BindIP     net.IP
+	Replica    Database `env:"RO"`
+	Ignored    string   `env:"-"`
+	unexported string
+}
+
+func lookupMap(env map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		v, ok := env[name]
+		return v, ok
+	}
+}
+
+func TestVars(t *testing.T) {
+	cfg := Config{HTTPPort: 8080, Database: Database{Timeout: 5 * time.Second}, Hosts: []string{"a", "b"}}
+	vars, err := Vars("APP", &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Var{
+		{Name: "APP_DEBUG", Field: "Debug", Type: "bool", Value: "false"},
+		{Name: "APP_HTTP_PORT", Field: "HTTPPort", Type: "uint16", Value: "8080"},
+		{Name: "APP_API_KEY", Field: "APIKey", Type: "string", Value: ""},
+		{Name: "APP_RATIO", Field: "Ratio", Type: "float64", Value: "0"},
+		{Name: "APP_DATABASE_MAX_OPEN_CONNS", Field: "Database.MaxOpenConns", Type: "int", Value: "0"},
+		{Name: "APP_DATABASE_TIMEOUT", Field: "Database.Timeout", Type: "time.Duration", Value: "5s"},
+		{Name: "APP_HOSTS", Field: "Hosts", Type: "[]string", Value: "a,b"},
+		{Name: "APP_PORTS", Field: "Ports", Type: "[]int", Value: ""},
+		{Name: "APP_BIND_IP", Field: "BindIP", Type: "net.IP", Value: ""},
+		{Name: "APP_RO_MAX_OPEN_CONNS", Field: "Replica.MaxOpenConns", Type: "int", Value: "0"},
+		{Name: "APP_RO_TIMEOUT", Field: "Replica.Timeout", Type: "time.Duration", Value: "0s"},
+	}
+	if len(vars) != len(want) {
+		t.Fatalf("Vars() = %v, want %v", vars, want)
+	}
+	for i := range want {
+		vars[i].index = nil
+		if !reflect.DeepEqual(vars[i], want[i]) {
+			t.Errorf("Vars()[%d] = %+v, want %+v", i, vars[i], want[i])
+		}
+	}
+}
+
+func TestLoadFunc(t *testing.T) {
+	cfg := Config{Ratio: 0.5, Hosts: []string{"default"}}
+	env := map[string]string{
+		"APP_DEBUG":                   "true",
+		"APP_HTTP_PORT":               "9090",
+		"APP_API_KEY":                 "secret",
+		"APP_DATABASE_MAX_OPEN_CONNS": "10",
+		"APP_DATABASE_TIMEOUT":        "1m30s",
+		"APP_HOSTS":                   "a.example, b.example",
+		"APP_PORTS":                   "80,443",
+		"APP_BIND_IP":                 "10.0.0.1",
+		"APP_IGNORED":                 "x",
+	}
+	if err := New("APP", strcase.ToScreamingSnakeCase).LoadFunc(&cfg, lookupMap(env)); err != nil {
+		t.Fatal(err)
+	}
+	want := Config{
+		Common:   Common{Debug: true},
+		HTTPPort: 9090,
+		APIKey:   "secret",
+		Ratio:    0.5,
+		Database: Database{MaxOpenConns: 10, Timeout: 90 * time.Second},
+		Hosts:    []string{"a.example", "b.example"},
+		Ports:    []int{80, 443},
+		BindIP:   net.ParseIP("10.0.0.1"),
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("LoadFunc() = %+v, want %+v", cfg, want)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	if err := os.Setenv("TEST_DATABASE_MAX_OPEN_CONNS", "3"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Unsetenv("TEST_DATABASE_MAX_OPEN_CONNS")
+	var cfg Config
+	if err := Load("TEST", &cfg); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Database.MaxOpenConns != 3 {
+		t.Errorf("Load() MaxOpenConns = %d, want 3", cfg.Database.MaxOpenConns)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		dest interface{}
+		env  map[string]string
+		want string
+	}{
+		{name: "not pointer", dest: Config{}, want: "non-nil pointer to struct"},
+		{name: "int", dest: &Config{}, env: map[string]string{"HTTP_PORT": "70000"}, want: "HTTP_PORT"},
+		{name: "duration", dest: &Config{}, env: map[string]string{"DATABASE_TIMEOUT": "soon"}, want: "DATABASE_TIMEOUT"},
+		{name: "unsupported", dest: &struct{ Next *Config }{}, want: "unsupported type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := New("", strcase.ToScreamingSnakeCase).LoadFunc(tt.dest, lookupMap(tt.env))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("LoadFunc() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrint(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Print(&buf, "APP", Database{MaxOpenConns: 4}); err != nil {
+		t.Fatal(err)
+	}
+	want := "APP_MAX_OPEN_CONNS=4\nAPP_TIMEOUT=0s\n"
+	if buf.String() != want {
+		t.Errorf("Print() = %q, want %q", buf.String(), want)
+	}
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package flagcase registers flags for struct fields named by the kebab-case of field paths.
+//
+//	type Config struct {
+//		MaxRetryCount int               // -max-retry-count, usage "Max retry count"
+//		Database struct {
+//			Timeout time.Duration       // -database-timeout
+//		}
+//		Hosts []string `flag:"host" usage:"Backend host, repeatable"`
+//	}
+//
+//	cfg := Config{MaxRetryCount: 3}
+//	err := flagcase.Register(flag.CommandLine, "", &cfg)
+//	flag.Parse()
+package flagcase
+
+import (
+	"flag"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/nikitaksv/strcase"
+	"github.com/nikitaksv/strcase/internal/fieldvalue"
+)
+
+// DefaultTag Tag with flag name overrides of fields
+const DefaultTag = "flag"
+
+// UsageTag Tag with usage text overrides of fields
+const UsageTag = "usage"
+
+// Separator Separator of prefix and field path names
+const Separator = "-"
+
+// Registrar Registers flags for struct fields
+type Registrar struct {
+	prefix  string
+	convert func(string) string
+	tag     string
+}
+
+// New Registrar with the prefix of flag names and the converter of field names. Ex. New("db", strcase.ToKebabCaseAcronym)
+func New(prefix string, convert func(string) string) *Registrar {
+	return NewWithTag(prefix, convert, DefaultTag)
+}
+
+// NewWithTag Registrar with the prefix, the converter of field names and the tag overrides
+func NewWithTag(prefix string, convert func(string) string, tag string) *Registrar {
+	return &Registrar{prefix: prefix, convert: convert, tag: tag}
+}
+
+// Register Defines flags on fs for fields of dest, a pointer to struct, the current field values are the defaults.
+// Nested structs add their name to the path, embedded structs without tag are flattened,
+// fields with tag "-" and unexported fields are skipped. Tag replaces the name of the field in the path.
+// Usage is the Sentence case of the field path unless set by the usage tag.
+// Slice flags are comma separated and repeatable.
+func (r *Registrar) Register(fs *flag.FlagSet, dest interface{}) error {
+	v := reflect.ValueOf(dest)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("flagcase: dest must be a non-nil pointer to struct, got %T", dest)
+	}
+	return r.register(fs, v.Elem(), r.prefix, "")
+}
+
+func (r *Registrar) register(fs *flag.FlagSet, v reflect.Value, name, field string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, hasTag := f.Tag.Lookup(r.tag)
+		fName := strings.SplitN(tag, ",", 2)[0]
+		if fName == "-" {
+			continue
+		}
+		fv := v.Field(i)
+		if f.Anonymous && !hasTag && fieldvalue.IsNested(f.Type) {
+			if err := r.register(fs, fv, name, field); err != nil {
+				return err
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if fName == "" {
+			fName = r.convert(f.Name)
+		}
+		fName = join(name, fName, Separator)
+		fField := join(field, f.Name, " ")
+		if fieldvalue.IsNested(f.Type) {
+			if err := r.register(fs, fv, fName, fField); err != nil {
+				return err
+			}
+			continue
+		}
+		if !fieldvalue.IsSupported(f.Type) {
+			return fmt.Errorf("flagcase: unsupported type %s of field %s", f.Type, strings.Replace(fField, " ", ".", -1))
+		}
+		if fs.Lookup(fName) != nil {
+			return fmt.Errorf("flagcase: flag redefined: %s", fName)
+		}
+		usage, ok := f.Tag.Lookup(UsageTag)
+		if !ok {
+			usage = strcase.ToSentenceCaseAcronym(fField)
+		}
+		fs.Var(&value{v: fv}, fName, usage)
+	}
+	return nil
+}
+
+// Register Defines flags on fs for fields of dest by kebab-case with acronyms and the prefix
+func Register(fs *flag.FlagSet, prefix string, dest interface{}) error {
+	return New(prefix, strcase.ToKebabCaseAcronym).Register(fs, dest)
+}
+
+func join(prefix, name, sep string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + sep + name
+}
+
+// value flag.Value of the struct field
+type value struct {
+	v   reflect.Value
+	set bool
+}
+
+func (f *value) String() string {
+	if f == nil || !f.v.IsValid() {
+		return ""
+	}
+	s, _ := fieldvalue.Format(f.v)
+	return s
+}
+
+func (f *value) Set(s string) error {
+	if f.v.Kind() != reflect.Slice {
+		return fieldvalue.Parse(f.v, s)
+	}
+	items := reflect.New(f.v.Type()).Elem()
+	if err := fieldvalue.Parse(items, s); err != nil {
+		return err
+	}
+	if f.set {
+		items = reflect.AppendSlice(f.v, items)
+	}
+	f.v.Set(items)
+	f.set = true
+	return nil
+}
+
+func (f *value) IsBoolFlag() bool {
+	return f.v.Kind() == reflect.Bool
+}
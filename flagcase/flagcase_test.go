@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flagcase
+
+import (
+	"flag"
+	"io/ioutil"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nikitaksv/strcase"
+)
+
+type Database struct {
+	MaxOpenConns int
+	Timeout      time.Duration
+}
+
+type Common struct {
+	Verbose bool
+}
+
+type Config struct {
+	Common
+	MaxRetryCount int
+	UserID        string
+	Database      Database
+	Replica       Database `flag:"ro"`
+	Hosts         []string `flag:"host" usage:"Backend host, repeatable"`
+	BindIP        net.IP
+	Ignored       string `flag:"-"`
+	unexported    string
+}
+
+func TestRegister(t *testing.T) {
+	cfg := Config{MaxRetryCount: 3, Hosts: []string{"default"}}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	if err := Register(fs, "", &cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	fs.VisitAll(func(f *flag.Flag) {
+		got = append(got, f.Name+"="+f.DefValue+" "+f.Usage)
+	})
+	want := []string{
+		"bind-IP= Bind IP",
+		"database-max-open-conns=0 Database max open conns",
+		"database-timeout=0s Database timeout",
+		"host=default Backend host, repeatable",
+		"max-retry-count=3 Max retry count",
+		"ro-max-open-conns=0 Replica max open conns",
+		"ro-timeout=0s Replica timeout",
+		"user-ID= User ID",
+		"verbose=false Verbose",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Register() flags =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	err := fs.Parse([]string{
+		"-verbose", "-max-retry-count", "5", "-user-ID", "u1", "-database-timeout", "1m",
+		"-ro-max-open-conns=2", "-host", "a,b", "-host", "c", "-bind-IP", "10.0.0.1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantCfg := Config{
+		Common:        Common{Verbose: true},
+		MaxRetryCount: 5,
+		UserID:        "u1",
+		Database:      Database{Timeout: time.Minute},
+		Replica:       Database{MaxOpenConns: 2},
+		Hosts:         []string{"a", "b", "c"},
+		BindIP:        net.ParseIP("10.0.0.1"),
+	}
+	if !reflect.DeepEqual(cfg, wantCfg) {
+		t.Errorf("Parse() = %+v, want %+v", cfg, wantCfg)
+	}
+}
+
+func TestRegisterPrefix(t *testing.T) {
+	var cfg Database
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	if err := New("db", strcase.ToKebabCase).Register(fs, &cfg); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.Parse([]string{"-db-max-open-conns", "7"}); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.MaxOpenConns != 7 {
+		t.Errorf("MaxOpenConns = %d, want 7", cfg.MaxOpenConns)
+	}
+}
+
+func TestRegisterErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		dest interface{}
+		want string
+	}{
+		{name: "not pointer", dest: Config{}, want: "non-nil pointer to struct"},
+		{name: "unsupported", dest: &struct{ Next *Config }{}, want: "unsupported type"},
+		{name: "redefined", dest: &struct {
+			A string `flag:"name"`
+			B string `flag:"name"`
+		}{}, want: "flag redefined: name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Register(flag.NewFlagSet("test", flag.ContinueOnError), "", tt.dest)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Register() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	var cfg Config
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	if err := Register(fs, "", &cfg); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.Parse([]string{"-database-timeout", "soon"}); err == nil {
+		t.Error("Parse() error = nil, want invalid duration")
+	}
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+// FuncMap Conversions for text/template and html/template, the names are stable. Ex. {{ snakeCase .Name }}
+//
+// The map is assignable to template.FuncMap: template.New("").Funcs(strcase.FuncMap())
+func FuncMap() map[string]interface{} {
+	return map[string]interface{}{
+		"mergeCase":                 ToMergeCase,
+		"mergeCaseAcronym":          ToMergeCaseAcronym,
+		"snakeCase":                 ToSnakeCase,
+		"snakeCaseAcronym":          ToSnakeCaseAcronym,
+		"screamingSnakeCase":        ToScreamingSnakeCase,
+		"screamingSnakeCaseAcronym": ToScreamingSnakeCaseAcronym,
+		"kebabCase":                 ToKebabCase,
+		"kebabCaseAcronym":          ToKebabCaseAcronym,
+		"dotCase":                   ToDotCase,
+		"dotCaseAcronym":            ToDotCaseAcronym,
+		"camelCase":                 ToCamelCase,
+		"camelCaseAcronym":          ToCamelCaseAcronym,
+		"pascalCase":                ToPascalCase,
+		"pascalCaseAcronym":         ToPascalCaseAcronym,
+		"titleCase":                 ToTitleCase,
+		"titleCaseAcronym":          ToTitleCaseAcronym,
+		"sentenceCase":              ToSentenceCase,
+		"sentenceCaseAcronym":       ToSentenceCaseAcronym,
+		"trainCase":                 ToTrainCase,
+		"trainCaseAcronym":          ToTrainCaseAcronym,
+		"pluralize":                 Pluralize,
+		"singularize":               Singularize,
+		"detectCase":                DetectCase,
+	}
+}
+
+// FuncMap Same names as the package FuncMap, the Acronym variants use the Converter dictionary
+func (c *Converter) FuncMap() map[string]interface{} {
+	m := FuncMap()
+	m["mergeCaseAcronym"] = c.ToMergeCase
+	m["snakeCaseAcronym"] = c.ToSnakeCase
+	m["screamingSnakeCaseAcronym"] = c.ToScreamingSnakeCase
+	m["kebabCaseAcronym"] = c.ToKebabCase
+	m["dotCaseAcronym"] = c.ToDotCase
+	m["camelCaseAcronym"] = c.ToCamelCase
+	m["pascalCaseAcronym"] = c.ToPascalCase
+	m["titleCaseAcronym"] = c.ToTitleCase
+	m["sentenceCaseAcronym"] = c.ToSentenceCase
+	m["trainCaseAcronym"] = c.ToTrainCase
+	return m
+}
@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"bytes"
+	"flag"
+	htmltemplate "html/template"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"testing"
+	"text/template"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+var funcMapData = struct {
+	Fields []string
+}{
+	Fields: []string{"user_id", "orderItemUrl", "HTTP_STATUS", "category name"},
+}
+
+func TestFuncMapGolden(t *testing.T) {
+	src, err := ioutil.ReadFile(filepath.Join("testdata", "funcmap.tmpl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden := filepath.Join("testdata", "funcmap.golden")
+	c := NewConverter(map[string][]string{"ID": {"id", "Id"}, "URL": {"url", "Url"}, "HTTP": nil})
+
+	var text bytes.Buffer
+	tmpl := template.Must(template.New("funcmap").Funcs(c.FuncMap()).Parse(string(src)))
+	if err := tmpl.Execute(&text, funcMapData); err != nil {
+		t.Fatal(err)
+	}
+	if *update {
+		if err := ioutil.WriteFile(golden, text.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text.String() != string(want) {
+		t.Errorf("text/template output mismatch (-update to regenerate)\ngot:\n%s\nwant:\n%s", text.String(), want)
+	}
+
+	var html bytes.Buffer
+	htmlTmpl := htmltemplate.Must(htmltemplate.New("funcmap").Funcs(c.FuncMap()).Parse(string(src)))
+	if err := htmlTmpl.Execute(&html, funcMapData); err != nil {
+		t.Fatal(err)
+	}
+	if html.String() != string(want) {
+		t.Errorf("html/template output mismatch\ngot:\n%s\nwant:\n%s", html.String(), want)
+	}
+}
+
+func TestFuncMap(t *testing.T) {
+	want := []string{
+		"camelCase", "camelCaseAcronym", "detectCase", "dotCase", "dotCaseAcronym",
+		"kebabCase", "kebabCaseAcronym", "mergeCase", "mergeCaseAcronym",
+		"pascalCase", "pascalCaseAcronym", "pluralize",
+		"screamingSnakeCase", "screamingSnakeCaseAcronym", "sentenceCase", "sentenceCaseAcronym", "singularize",
+		"snakeCase", "snakeCaseAcronym", "titleCase", "titleCaseAcronym", "trainCase", "trainCaseAcronym",
+	}
+	for name, m := range map[string]map[string]interface{}{
+		"package":   FuncMap(),
+		"converter": NewConverter(nil).FuncMap(),
+	} {
+		got := make([]string, 0, len(m))
+		for k, f := range m {
+			got = append(got, k)
+			if _, ok := f.(func(string) string); !ok {
+				t.Errorf("%s FuncMap()[%q] = %T, want func(string) string", name, k, f)
+			}
+		}
+		sort.Strings(got)
+		if len(got) != len(want) {
+			t.Fatalf("%s FuncMap() names = %v, want %v", name, got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("%s FuncMap() names = %v, want %v", name, got, want)
+				break
+			}
+		}
+	}
+}
+
+func TestConverter(t *testing.T) {
+	c := NewConverter(map[string][]string{"API": nil})
+	c.AddAcronym("JSON", "json", "Json")
+	tests := []struct {
+		f    func(string) string
+		str  string
+		want string
+	}{
+		{f: c.ToMergeCase, str: "json api", want: "JSONAPI"},
+		{f: c.ToSnakeCase, str: "jsonApi", want: "JSON_API"},
+		{f: c.ToScreamingSnakeCase, str: "user json", want: "USER_JSON"},
+		{f: c.ToKebabCase, str: "user_api", want: "user-API"},
+		{f: c.ToDotCase, str: "user api", want: "user.API"},
+		{f: c.ToCamelCase, str: "user_api_json_id", want: "userAPIJSONId"},
+		{f: c.ToPascalCase, str: "api_json_id", want: "APIJSONId"},
+		{f: c.ToTitleCase, str: "user_json", want: "User JSON"},
+		{f: c.ToSentenceCase, str: "UserJson", want: "User JSON"},
+		{f: c.ToTrainCase, str: "user_json", want: "User-JSON"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.f(tt.str); got != tt.want {
+				t.Errorf("Converter = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if got, found := c.ReplaceAcronym("Json"); !found || got != "JSON" {
+		t.Errorf("ReplaceAcronym() = %v, %v, want JSON, true", got, found)
+	}
+	if _, found := c.ReplaceAcronym("id"); found {
+		t.Error("ReplaceAcronym() found global acronym ID in Converter dictionary")
+	}
+}
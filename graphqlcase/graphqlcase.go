@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package graphqlcase converts names to the GraphQL conventions of their schema position:
+// PascalCase types, camelCase fields and arguments, SCREAMING_SNAKE_CASE enum values.
+//
+//	graphqlcase.Name("user_profile", graphqlcase.TypeName)      // UserProfile
+//	graphqlcase.Name("created_at", graphqlcase.FieldName)       // createdAt
+//	graphqlcase.Name("in progress", graphqlcase.EnumValueName)  // IN_PROGRESS
+//	sdl, err := graphqlcase.RenameSDL(`type user_profile { created_at: String }`)
+package graphqlcase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nikitaksv/strcase"
+)
+
+// Position Position of a name in the schema
+type Position int
+
+const (
+	// TypeName Name of object, interface, union, enum, input and scalar types
+	TypeName Position = iota
+	// FieldName Name of object, interface and input fields
+	FieldName
+	// ArgumentName Name of field arguments
+	ArgumentName
+	// EnumValueName Name of enum values
+	EnumValueName
+)
+
+func (p Position) String() string {
+	switch p {
+	case TypeName:
+		return "type"
+	case FieldName:
+		return "field"
+	case ArgumentName:
+		return "argument"
+	case EnumValueName:
+		return "enum value"
+	}
+	return fmt.Sprintf("Position(%d)", int(p))
+}
+
+var (
+	// ErrInvalidName Name does not match /[_A-Za-z][_0-9A-Za-z]*/
+	ErrInvalidName = errors.New("graphqlcase: invalid name")
+	// ErrReservedName Name starts with "__", reserved for introspection
+	ErrReservedName = errors.New("graphqlcase: reserved name")
+)
+
+// builtinTypes Built-in scalars, kept by the type conversion
+var builtinTypes = map[string]bool{"Int": true, "Float": true, "String": true, "Boolean": true, "ID": true}
+
+// enumKeywords Names which are not valid enum values
+var enumKeywords = map[string]bool{"true": true, "false": true, "null": true}
+
+// Converter Converters of names by position, nil converters fall back to Default
+type Converter struct {
+	Type      func(string) string
+	Field     func(string) string
+	Argument  func(string) string
+	EnumValue func(string) string
+}
+
+// Default Converter of the GraphQL conventions
+var Default = Converter{
+	Type:      strcase.ToPascalCase,
+	Field:     strcase.ToCamelCase,
+	Argument:  strcase.ToCamelCase,
+	EnumValue: strcase.ToScreamingSnakeCase,
+}
+
+// Name Name converted for the position and validated. Built-in scalar type names are kept.
+// Names starting with "__" are rejected before conversion, which would drop the prefix
+func (c Converter) Name(name string, pos Position) (string, error) {
+	if strings.HasPrefix(name, "__") {
+		return "", fmt.Errorf("%w: %s name %q", ErrReservedName, pos, name)
+	}
+	var convert, fallback func(string) string
+	switch pos {
+	case TypeName:
+		convert, fallback = c.Type, Default.Type
+	case FieldName:
+		convert, fallback = c.Field, Default.Field
+	case ArgumentName:
+		convert, fallback = c.Argument, Default.Argument
+	case EnumValueName:
+		convert, fallback = c.EnumValue, Default.EnumValue
+	default:
+		return "", fmt.Errorf("graphqlcase: unknown position %v", pos)
+	}
+	if convert == nil {
+		convert = fallback
+	}
+	converted := name
+	if pos != TypeName || !builtinTypes[name] {
+		converted = convert(name)
+	}
+	if err := validate(converted); err != nil {
+		return "", fmt.Errorf("%w: %s name %q of %q", err, pos, converted, name)
+	}
+	if pos == EnumValueName && enumKeywords[converted] {
+		return "", fmt.Errorf("%w: %s name %q of %q", ErrInvalidName, pos, converted, name)
+	}
+	return converted, nil
+}
+
+// Name Name converted for the position by Default
+func Name(name string, pos Position) (string, error) {
+	return Default.Name(name, pos)
+}
+
+// RenameSDL Schema document with names converted by Default
+func RenameSDL(sdl string) (string, error) {
+	return Default.RenameSDL(sdl)
+}
+
+// Validate Name matches the GraphQL Name grammar /[_A-Za-z][_0-9A-Za-z]*/ and does not start with "__"
+func Validate(name string) error {
+	if err := validate(name); err != nil {
+		if name == "" {
+			return fmt.Errorf("%w: empty", err)
+		}
+		return fmt.Errorf("%w: %q", err, name)
+	}
+	return nil
+}
+
+// validate ErrInvalidName or ErrReservedName without details
+func validate(name string) error {
+	if name == "" {
+		return ErrInvalidName
+	}
+	for i := 0; i < len(name); i++ {
+		if c := name[i]; !isNameStart(c) && (i == 0 || !isDigit(c)) {
+			return ErrInvalidName
+		}
+	}
+	if strings.HasPrefix(name, "__") {
+		return ErrReservedName
+	}
+	return nil
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphqlcase
+
+import (
+	"errors"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestName(t *testing.T) {
+	tests := []struct {
+		name string
+		pos  Position
+		want string
+	}{
+		{name: "user_profile", pos: TypeName, want: "UserProfile"},
+		{name: "ID", pos: TypeName, want: "ID"},
+		{name: "String", pos: TypeName, want: "String"},
+		{name: "created_at", pos: FieldName, want: "createdAt"},
+		{name: "MaxLength", pos: ArgumentName, want: "maxLength"},
+		{name: "in progress", pos: EnumValueName, want: "IN_PROGRESS"},
+		{name: "inProgress", pos: EnumValueName, want: "IN_PROGRESS"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Name(tt.name, tt.pos)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Name() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		want error
+	}{
+		{name: "userName", want: nil},
+		{name: "_private", want: nil},
+		{name: "field2", want: nil},
+		{name: "", want: ErrInvalidName},
+		{name: "2fa", want: ErrInvalidName},
+		{name: "user-name", want: ErrInvalidName},
+		{name: "имя", want: ErrInvalidName},
+		{name: "__typename", want: ErrReservedName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate(tt.name); !errors.Is(err, tt.want) || (err == nil) != (tt.want == nil) {
+				t.Errorf("Validate() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+	_, err := Name("2fa code", FieldName)
+	if want := `graphqlcase: invalid name: field name "2faCode" of "2fa code"`; !errors.Is(err, ErrInvalidName) || err.Error() != want {
+		t.Errorf("Name() error = %v, want %s", err, want)
+	}
+	for _, pos := range []Position{TypeName, FieldName, ArgumentName, EnumValueName} {
+		if got, err := Name("__typename", pos); !errors.Is(err, ErrReservedName) {
+			t.Errorf("Name(%v) = %v, %v, want ErrReservedName", pos, got, err)
+		}
+	}
+}
+
+func TestConverterName(t *testing.T) {
+	c := Converter{EnumValue: strings.ToLower}
+	for _, name := range []string{"TRUE", "False", "null"} {
+		if got, err := c.Name(name, EnumValueName); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Name(%q) = %v, %v, want ErrInvalidName", name, got, err)
+		}
+	}
+	if got, err := c.Name("IN_PROGRESS", EnumValueName); err != nil || got != "in_progress" {
+		t.Errorf("Name() = %v, %v, want in_progress", got, err)
+	}
+
+	tests := []struct {
+		pos  Position
+		want string
+	}{
+		{TypeName, "UserProfile"},
+		{FieldName, "userProfile"},
+		{ArgumentName, "userProfile"},
+	}
+	for _, tt := range tests {
+		if got, err := c.Name("user_profile", tt.pos); err != nil || got != tt.want {
+			t.Errorf("Name(%v) = %v, %v, want %v", tt.pos, got, err, tt.want)
+		}
+	}
+}
+
+func TestRenameSDL(t *testing.T) {
+	src, err := ioutil.ReadFile(filepath.Join("testdata", "schema.graphql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := RenameSDL(string(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden := filepath.Join("testdata", "schema.golden.graphql")
+	if *update {
+		if err := ioutil.WriteFile(golden, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("RenameSDL() mismatch (-update to regenerate)\ngot:\n%s\nwant:\n%s", got, want)
+	}
+
+	again, err := RenameSDL(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != got {
+		t.Errorf("RenameSDL() is not idempotent\ngot:\n%s", again)
+	}
+}
+
+func TestRenameSDLErrors(t *testing.T) {
+	tests := []struct {
+		sdl  string
+		want string
+	}{
+		{sdl: `query { user { id } }`, want: "1:1: unexpected \"query\""},
+		{sdl: `type user { name: String`, want: "expected name"},
+		{sdl: `type user { "unterminated }`, want: "1:13: unterminated string"},
+		{sdl: `type user { _2fa: String }`, want: "invalid name"},
+		{sdl: `type user { __typename: String }`, want: "1:13: graphqlcase: reserved name"},
+		{sdl: `type __user_type { id: ID }`, want: "1:6: graphqlcase: reserved name"},
+		{sdl: `enum status { __ACTIVE }`, want: "reserved name"},
+		{sdl: "type user {\n  id: ID ~\n}", want: "2:10: unexpected character"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sdl, func(t *testing.T) {
+			_, err := RenameSDL(tt.sdl)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("RenameSDL() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,553 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphqlcase
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokName
+	tokPunct
+	tokNumber
+	tokString
+)
+
+type token struct {
+	kind       tokenKind
+	start, end int
+}
+
+// lex Significant tokens of the document, whitespace, commas and comments are skipped
+func lex(src string) ([]token, error) {
+	var toks []token
+	i := 0
+	for i < len(src) {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i++
+		case strings.HasPrefix(src[i:], "\ufeff"):
+			i += len("\ufeff")
+		case c == '#':
+			for i < len(src) && src[i] != '\n' && src[i] != '\r' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "..."):
+			toks = append(toks, token{kind: tokPunct, start: i, end: i + 3})
+			i += 3
+		case strings.IndexByte("!$&():=@[]{}|", c) >= 0:
+			toks = append(toks, token{kind: tokPunct, start: i, end: i + 1})
+			i++
+		case isNameStart(c):
+			start := i
+			for i < len(src) && (isNameStart(src[i]) || isDigit(src[i])) {
+				i++
+			}
+			toks = append(toks, token{kind: tokName, start: start, end: i})
+		case c == '-' || isDigit(c):
+			start := i
+			i++
+			for i < len(src) && (isDigit(src[i]) || strings.IndexByte(".eE+-", src[i]) >= 0) {
+				i++
+			}
+			toks = append(toks, token{kind: tokNumber, start: start, end: i})
+		case strings.HasPrefix(src[i:], `"""`):
+			end := strings.Index(strings.Replace(src[i+3:], `\"""`, "\x00\x00\x00\x00", -1), `"""`)
+			if end < 0 {
+				return nil, syntaxError(src, i, "unterminated block string")
+			}
+			toks = append(toks, token{kind: tokString, start: i, end: i + 3 + end + 3})
+			i += 3 + end + 3
+		case c == '"':
+			start := i
+			for i++; ; i++ {
+				if i >= len(src) || src[i] == '\n' || src[i] == '\r' {
+					return nil, syntaxError(src, start, "unterminated string")
+				}
+				if src[i] == '\\' {
+					i++
+				} else if src[i] == '"' {
+					break
+				}
+			}
+			i++
+			toks = append(toks, token{kind: tokString, start: start, end: i})
+		default:
+			return nil, syntaxError(src, i, fmt.Sprintf("unexpected character %q", c))
+		}
+	}
+	return append(toks, token{kind: tokEOF, start: len(src), end: len(src)}), nil
+}
+
+func syntaxError(src string, offset int, msg string) error {
+	line := strings.Count(src[:offset], "\n") + 1
+	col := offset - strings.LastIndexByte(src[:offset], '\n')
+	return fmt.Errorf("graphqlcase: %d:%d: %s", line, col, msg)
+}
+
+// RenameSDL Schema document with type, field, argument and enum value names converted.
+// Descriptions, strings, comments and formatting are kept, directive names and arguments are kept.
+// Type references and default values are converted as their definitions, also in directive definitions.
+// Only type system definitions and extensions are supported.
+func (c Converter) RenameSDL(sdl string) (string, error) {
+	toks, err := lex(sdl)
+	if err != nil {
+		return "", err
+	}
+	p := &sdlParser{src: sdl, toks: toks, c: c, edits: map[int]string{}}
+	if err := p.document(); err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	last := 0
+	for i, t := range toks {
+		if name, ok := p.edits[i]; ok {
+			b.WriteString(sdl[last:t.start])
+			b.WriteString(name)
+			last = t.end
+		}
+	}
+	b.WriteString(sdl[last:])
+	return b.String(), nil
+}
+
+// sdlParser Records conversions of name tokens by position in edits
+type sdlParser struct {
+	src   string
+	toks  []token
+	p     int
+	c     Converter
+	edits map[int]string
+}
+
+func (p *sdlParser) peek() token {
+	return p.toks[p.p]
+}
+
+func (p *sdlParser) text(t token) string {
+	return p.src[t.start:t.end]
+}
+
+func (p *sdlParser) is(kind tokenKind, text string) bool {
+	t := p.peek()
+	return t.kind == kind && p.text(t) == text
+}
+
+func (p *sdlParser) errorf(format string, args ...interface{}) error {
+	return syntaxError(p.src, p.peek().start, fmt.Sprintf(format, args...))
+}
+
+func (p *sdlParser) expect(punct string) error {
+	if !p.is(tokPunct, punct) {
+		return p.errorf("expected %q, found %q", punct, p.text(p.peek()))
+	}
+	p.p++
+	return nil
+}
+
+// skipName Skips a name kept as is
+func (p *sdlParser) skipName() error {
+	if p.peek().kind != tokName {
+		return p.errorf("expected name, found %q", p.text(p.peek()))
+	}
+	p.p++
+	return nil
+}
+
+// name Converts the name for the position
+func (p *sdlParser) name(pos Position) error {
+	t := p.peek()
+	if t.kind != tokName {
+		return p.errorf("expected name, found %q", p.text(t))
+	}
+	name, err := p.c.Name(p.text(t), pos)
+	if err != nil {
+		return syntaxError(p.src, t.start, err.Error())
+	}
+	if name != p.text(t) {
+		p.edits[p.p] = name
+	}
+	p.p++
+	return nil
+}
+
+func (p *sdlParser) description() {
+	if p.peek().kind == tokString {
+		p.p++
+	}
+}
+
+func (p *sdlParser) document() error {
+	for p.peek().kind != tokEOF {
+		p.description()
+		if p.is(tokName, "extend") {
+			p.p++
+		}
+		if p.peek().kind != tokName {
+			return p.errorf("expected definition, found %q", p.text(p.peek()))
+		}
+		var err error
+		switch p.text(p.peek()) {
+		case "schema":
+			err = p.schemaDefinition()
+		case "scalar":
+			err = p.scalarDefinition()
+		case "type", "interface":
+			err = p.objectDefinition()
+		case "union":
+			err = p.unionDefinition()
+		case "enum":
+			err = p.enumDefinition()
+		case "input":
+			err = p.inputDefinition()
+		case "directive":
+			err = p.directiveDefinition()
+		default:
+			err = p.errorf("unexpected %q, only type system definitions are supported", p.text(p.peek()))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *sdlParser) schemaDefinition() error {
+	p.p++
+	if err := p.directives(); err != nil {
+		return err
+	}
+	if !p.is(tokPunct, "{") {
+		return nil
+	}
+	p.p++
+	for !p.is(tokPunct, "}") {
+		if err := p.skipName(); err != nil {
+			return err
+		}
+		if err := p.expect(":"); err != nil {
+			return err
+		}
+		if err := p.name(TypeName); err != nil {
+			return err
+		}
+	}
+	p.p++
+	return nil
+}
+
+func (p *sdlParser) scalarDefinition() error {
+	p.p++
+	if err := p.name(TypeName); err != nil {
+		return err
+	}
+	return p.directives()
+}
+
+func (p *sdlParser) objectDefinition() error {
+	p.p++
+	if err := p.name(TypeName); err != nil {
+		return err
+	}
+	if p.is(tokName, "implements") {
+		p.p++
+		if p.is(tokPunct, "&") {
+			p.p++
+		}
+		if err := p.name(TypeName); err != nil {
+			return err
+		}
+		for p.is(tokPunct, "&") {
+			p.p++
+			if err := p.name(TypeName); err != nil {
+				return err
+			}
+		}
+	}
+	if err := p.directives(); err != nil {
+		return err
+	}
+	if !p.is(tokPunct, "{") {
+		return nil
+	}
+	p.p++
+	for !p.is(tokPunct, "}") {
+		p.description()
+		if err := p.name(FieldName); err != nil {
+			return err
+		}
+		if p.is(tokPunct, "(") {
+			if err := p.argumentsDefinition(true); err != nil {
+				return err
+			}
+		}
+		if err := p.expect(":"); err != nil {
+			return err
+		}
+		if err := p.typeReference(); err != nil {
+			return err
+		}
+		if err := p.directives(); err != nil {
+			return err
+		}
+	}
+	p.p++
+	return nil
+}
+
+func (p *sdlParser) unionDefinition() error {
+	p.p++
+	if err := p.name(TypeName); err != nil {
+		return err
+	}
+	if err := p.directives(); err != nil {
+		return err
+	}
+	if !p.is(tokPunct, "=") {
+		return nil
+	}
+	p.p++
+	if p.is(tokPunct, "|") {
+		p.p++
+	}
+	if err := p.name(TypeName); err != nil {
+		return err
+	}
+	for p.is(tokPunct, "|") {
+		p.p++
+		if err := p.name(TypeName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *sdlParser) enumDefinition() error {
+	p.p++
+	if err := p.name(TypeName); err != nil {
+		return err
+	}
+	if err := p.directives(); err != nil {
+		return err
+	}
+	if !p.is(tokPunct, "{") {
+		return nil
+	}
+	p.p++
+	for !p.is(tokPunct, "}") {
+		p.description()
+		if err := p.name(EnumValueName); err != nil {
+			return err
+		}
+		if err := p.directives(); err != nil {
+			return err
+		}
+	}
+	p.p++
+	return nil
+}
+
+func (p *sdlParser) inputDefinition() error {
+	p.p++
+	if err := p.name(TypeName); err != nil {
+		return err
+	}
+	if err := p.directives(); err != nil {
+		return err
+	}
+	if !p.is(tokPunct, "{") {
+		return nil
+	}
+	p.p++
+	for !p.is(tokPunct, "}") {
+		if err := p.inputValue(FieldName, true); err != nil {
+			return err
+		}
+	}
+	p.p++
+	return nil
+}
+
+// directiveDefinition Keeps the directive and argument names, converts the argument types and default values
+func (p *sdlParser) directiveDefinition() error {
+	p.p++
+	if err := p.expect("@"); err != nil {
+		return err
+	}
+	if err := p.skipName(); err != nil {
+		return err
+	}
+	if p.is(tokPunct, "(") {
+		if err := p.argumentsDefinition(false); err != nil {
+			return err
+		}
+	}
+	if p.is(tokName, "repeatable") {
+		p.p++
+	}
+	if !p.is(tokName, "on") {
+		return p.errorf("expected \"on\", found %q", p.text(p.peek()))
+	}
+	p.p++
+	if p.is(tokPunct, "|") {
+		p.p++
+	}
+	if err := p.skipName(); err != nil {
+		return err
+	}
+	for p.is(tokPunct, "|") {
+		p.p++
+		if err := p.skipName(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *sdlParser) argumentsDefinition(rename bool) error {
+	p.p++
+	for !p.is(tokPunct, ")") {
+		if err := p.inputValue(ArgumentName, rename); err != nil {
+			return err
+		}
+	}
+	p.p++
+	return nil
+}
+
+// inputValue Argument or input field definition: description? name: Type = default @directives
+func (p *sdlParser) inputValue(pos Position, rename bool) error {
+	p.description()
+	var err error
+	if rename {
+		err = p.name(pos)
+	} else {
+		err = p.skipName()
+	}
+	if err != nil {
+		return err
+	}
+	if err := p.expect(":"); err != nil {
+		return err
+	}
+	if err := p.typeReference(); err != nil {
+		return err
+	}
+	if p.is(tokPunct, "=") {
+		p.p++
+		if err := p.value(); err != nil {
+			return err
+		}
+	}
+	return p.directives()
+}
+
+func (p *sdlParser) typeReference() error {
+	if p.is(tokPunct, "[") {
+		p.p++
+		if err := p.typeReference(); err != nil {
+			return err
+		}
+		if err := p.expect("]"); err != nil {
+			return err
+		}
+	} else if err := p.name(TypeName); err != nil {
+		return err
+	}
+	if p.is(tokPunct, "!") {
+		p.p++
+	}
+	return nil
+}
+
+// value Default value, enum values and input object fields are converted
+func (p *sdlParser) value() error {
+	t := p.peek()
+	switch {
+	case t.kind == tokNumber || t.kind == tokString:
+		p.p++
+	case t.kind == tokName:
+		switch p.text(t) {
+		case "true", "false", "null":
+			p.p++
+		default:
+			return p.name(EnumValueName)
+		}
+	case p.is(tokPunct, "["):
+		p.p++
+		for !p.is(tokPunct, "]") {
+			if p.peek().kind == tokEOF {
+				return p.errorf("unterminated list")
+			}
+			if err := p.value(); err != nil {
+				return err
+			}
+		}
+		p.p++
+	case p.is(tokPunct, "{"):
+		p.p++
+		for !p.is(tokPunct, "}") {
+			if err := p.name(FieldName); err != nil {
+				return err
+			}
+			if err := p.expect(":"); err != nil {
+				return err
+			}
+			if err := p.value(); err != nil {
+				return err
+			}
+		}
+		p.p++
+	default:
+		return p.errorf("unexpected %q in value", p.text(t))
+	}
+	return nil
+}
+
+// directives Skips directives with their arguments
+func (p *sdlParser) directives() error {
+	for p.is(tokPunct, "@") {
+		p.p++
+		if err := p.skipName(); err != nil {
+			return err
+		}
+		if !p.is(tokPunct, "(") {
+			continue
+		}
+		for depth := 0; ; p.p++ {
+			switch {
+			case p.peek().kind == tokEOF:
+				return p.errorf("unterminated directive arguments")
+			case p.is(tokPunct, "("):
+				depth++
+			case p.is(tokPunct, ")"):
+				depth--
+			}
+			if depth == 0 {
+				p.p++
+				break
+			}
+		}
+	}
+	return nil
+}
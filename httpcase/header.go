@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package httpcase
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/nikitaksv/strcase"
+)
+
+// HeaderAcronyms Acronyms of HTTP header names in the SetAcronyms format
+var HeaderAcronyms = map[string][]string{
+	"ID":   nil,
+	"WWW":  nil,
+	"DNT":  nil,
+	"ETag": nil,
+	"TE":   nil,
+	"DNS":  nil,
+	"CSP":  nil,
+	"XSS":  nil,
+}
+
+// HeaderConverter Converter with HeaderAcronyms, used by ToHeaderCase
+var HeaderConverter = strcase.NewConverter(HeaderAcronyms)
+
+// ToHeaderCase Train-Case of the header name with HeaderAcronyms. Ex. "x-request-id" -> "X-Request-ID", "www-authenticate" -> "WWW-Authenticate"
+//
+// Only the case of letters changes: names whose words are not separated by "-" only, ex. "X_Forwarded_For" or "xForwardedFor",
+// are returned unchanged, so they are never merged into another header.
+func ToHeaderCase(name string) string {
+	if header := HeaderConverter.ToTrainCase(name); strings.EqualFold(header, name) {
+		return header
+	}
+	return name
+}
+
+// LookupHeader Values of the header name in h, keys are matched case-insensitively.
+// Values of several matching keys are merged in the sorted order of keys. Ex. LookupHeader(h, "x-request-id") -> "X-Request-ID"
+func LookupHeader(h http.Header, name string) (string, []string, bool) {
+	if vs, ok := h[name]; ok {
+		return name, vs, true
+	}
+	var (
+		key    string
+		values []string
+		found  bool
+	)
+	for _, k := range sortedKeys(h) {
+		if strings.EqualFold(k, name) {
+			if !found {
+				key, found = k, true
+			}
+			values = append(values, h[k]...)
+		}
+	}
+	return key, values, found
+}
+
+// CanonicalHeader Copy of h with keys converted by ToHeaderCase, values of keys matching case-insensitively are merged.
+// Keys differing in more than case, ex. "X_Forwarded_For" and "X-Forwarded-For", are kept apart
+func CanonicalHeader(h http.Header) http.Header {
+	canonical := make(http.Header, len(h))
+	for _, k := range sortedKeys(h) {
+		name := ToHeaderCase(k)
+		canonical[name] = append(canonical[name], h[k]...)
+	}
+	return canonical
+}
+
+func sortedKeys(h http.Header) []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package httpcase
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestToHeaderCase(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "x-request-id", want: "X-Request-ID"},
+		{name: "X-Request-Id", want: "X-Request-ID"},
+		{name: "www-authenticate", want: "WWW-Authenticate"},
+		{name: "Www-Authenticate", want: "WWW-Authenticate"},
+		{name: "etag", want: "ETag"},
+		{name: "ETag", want: "ETag"},
+		{name: "dnt", want: "DNT"},
+		{name: "te", want: "TE"},
+		{name: "x-dns-prefetch-control", want: "X-DNS-Prefetch-Control"},
+		{name: "x-xss-protection", want: "X-XSS-Protection"},
+		{name: "content-security-policy-report-only", want: "Content-Security-Policy-Report-Only"},
+		{name: "x-csp-nonce", want: "X-CSP-Nonce"},
+		{name: "content_type", want: "content_type"},
+		{name: "X_Forwarded_For", want: "X_Forwarded_For"},
+		{name: "xForwardedFor", want: "xForwardedFor"},
+		{name: "accept-encoding", want: "Accept-Encoding"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToHeaderCase(tt.name); got != tt.want {
+				t.Errorf("ToHeaderCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupHeader(t *testing.T) {
+	h := http.Header{
+		"X-Request-ID":     {"a"},
+		"X-Request-Id":     {"b"},
+		"Content-Type":     {"application/json"},
+		"Www-Authenticate": {"Basic"},
+	}
+	tests := []struct {
+		name      string
+		wantKey   string
+		want      []string
+		wantFound bool
+	}{
+		{name: "Content-Type", wantKey: "Content-Type", want: []string{"application/json"}, wantFound: true},
+		{name: "x-request-id", wantKey: "X-Request-ID", want: []string{"a", "b"}, wantFound: true},
+		{name: "WWW-Authenticate", wantKey: "Www-Authenticate", want: []string{"Basic"}, wantFound: true},
+		{name: "ETag"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, values, found := LookupHeader(h, tt.name)
+			if key != tt.wantKey || !reflect.DeepEqual(values, tt.want) || found != tt.wantFound {
+				t.Errorf("LookupHeader() = %v, %v, %v, want %v, %v, %v", key, values, found, tt.wantKey, tt.want, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestCanonicalHeader(t *testing.T) {
+	h := http.Header{
+		"X-Request-Id":    {"b"},
+		"x-request-id":    {"c"},
+		"Etag":            {`"v1"`},
+		"X-Forwarded-For": {"10.0.0.1"},
+		"X_Forwarded_For": {"1.2.3.4"},
+	}
+	want := http.Header{
+		"X-Request-ID":    {"b", "c"},
+		"ETag":            {`"v1"`},
+		"X-Forwarded-For": {"10.0.0.1"},
+		"X_Forwarded_For": {"1.2.3.4"},
+	}
+	if got := CanonicalHeader(h); !reflect.DeepEqual(got, want) {
+		t.Errorf("CanonicalHeader() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,339 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package httpcase converts the case of JSON keys, query parameters and headers of HTTP messages.
+//
+//	h := httpcase.JSONHandler(mux, httpcase.JSONConfig{
+//		Request:  strcase.ToSnakeCase,  // {"userId":1} -> {"user_id":1}
+//		Response: strcase.ToCamelCase,  // {"user_id":1} -> {"userId":1}
+//		Paths:    []string{"/api/"},
+//	})
+package httpcase
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// DefaultMaxBodySize Limit of bodies rewritten by JSONHandler when JSONConfig.MaxBodySize is 0
+const DefaultMaxBodySize = 1 << 20
+
+// ErrBodyTooLarge Request body is larger than JSONConfig.MaxBodySize
+var ErrBodyTooLarge = errors.New("httpcase: body too large")
+
+// JSONConfig Key conversions of JSONHandler
+type JSONConfig struct {
+	// Request Converter of request body keys, nil keeps the keys. Ex. strcase.ToSnakeCase
+	Request func(string) string
+	// Response Converter of response body keys, nil keeps the keys. Ex. strcase.ToCamelCase
+	Response func(string) string
+	// Paths Allow-list of URL paths, a pattern ending in "/" matches the subtree as in http.ServeMux. Empty matches all paths
+	Paths []string
+	// MaxBodySize Limit of request and response bodies, DefaultMaxBodySize if 0.
+	// Larger requests are rejected with 413, larger responses are passed unchanged
+	MaxBodySize int64
+}
+
+// JSONHandler Rewrites object keys of application/json request and response bodies.
+// Request bodies are rewritten before h is called and get the new Content-Length,
+// invalid JSON and colliding keys are rejected with 400.
+// Response bodies are buffered and rewritten when h returns and get the new Content-Length,
+// invalid JSON and colliding keys are passed unchanged. Flushing the response ends buffering: the rest of a streamed body is not rewritten.
+func JSONHandler(h http.Handler, cfg JSONConfig) http.Handler {
+	if cfg.MaxBodySize == 0 {
+		cfg.MaxBodySize = DefaultMaxBodySize
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !matchPaths(cfg.Paths, r.URL.Path) {
+			h.ServeHTTP(w, r)
+			return
+		}
+		if cfg.Request != nil && r.Body != nil && r.Body != http.NoBody && isJSON(r.Header.Get("Content-Type")) {
+			if err := rewriteRequest(r, cfg.Request, cfg.MaxBodySize); err != nil {
+				code := http.StatusBadRequest
+				if errors.Is(err, ErrBodyTooLarge) {
+					code = http.StatusRequestEntityTooLarge
+				}
+				http.Error(w, err.Error(), code)
+				return
+			}
+		}
+		if cfg.Response == nil || r.Method == http.MethodHead {
+			h.ServeHTTP(w, r)
+			return
+		}
+		jw := &jsonResponseWriter{ResponseWriter: w, convert: cfg.Response, maxSize: cfg.MaxBodySize}
+		defer jw.close()
+		h.ServeHTTP(jw, r)
+	})
+}
+
+func rewriteRequest(r *http.Request, convert func(string) string, maxSize int64) error {
+	defer r.Body.Close()
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxSize+1))
+	if err != nil {
+		return err
+	}
+	if int64(len(body)) > maxSize {
+		return ErrBodyTooLarge
+	}
+	var buf bytes.Buffer
+	if err := RewriteKeys(&buf, bytes.NewReader(body), convert); err != nil {
+		return err
+	}
+	r.Body = ioutil.NopCloser(&buf)
+	r.ContentLength = int64(buf.Len())
+	r.Header.Set("Content-Length", strconv.Itoa(buf.Len()))
+	return nil
+}
+
+// jsonResponseWriter Buffers JSON bodies up to maxSize and rewrites them when the handler returns.
+// Invalid JSON, larger bodies and bodies flushed by the handler are written unchanged, other bodies are written as is
+type jsonResponseWriter struct {
+	http.ResponseWriter
+	convert func(string) string
+	maxSize int64
+
+	wroteHeader bool
+	code        int
+	// buf Body buffered for rewriting, nil once the header is written to ResponseWriter
+	buf *bytes.Buffer
+}
+
+func (w *jsonResponseWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	h := w.Header()
+	if code != http.StatusNoContent && code != http.StatusNotModified && isJSON(h.Get("Content-Type")) {
+		size, err := strconv.ParseInt(h.Get("Content-Length"), 10, 64)
+		if err != nil || size <= w.maxSize {
+			w.code = code
+			w.buf = &bytes.Buffer{}
+			return
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *jsonResponseWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		if w.Header().Get("Content-Type") == "" {
+			w.Header().Set("Content-Type", http.DetectContentType(p))
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.buf == nil {
+		return w.ResponseWriter.Write(p)
+	}
+	if int64(w.buf.Len()+len(p)) > w.maxSize {
+		if err := w.passThrough(); err != nil {
+			return 0, err
+		}
+		return w.ResponseWriter.Write(p)
+	}
+	return w.buf.Write(p)
+}
+
+// Flush Writes the buffered body unchanged, the rest of a flushed body is not rewritten
+func (w *jsonResponseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.buf != nil {
+		if err := w.passThrough(); err != nil {
+			return
+		}
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack Hijacks the connection of ResponseWriter, the buffered body is discarded
+func (w *jsonResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hj, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("httpcase: ResponseWriter does not implement http.Hijacker")
+	}
+	w.wroteHeader = true
+	w.buf = nil
+	return hj.Hijack()
+}
+
+// passThrough Stops buffering, writes the header and the buffered body unchanged
+func (w *jsonResponseWriter) passThrough() error {
+	body := w.buf.Bytes()
+	w.buf = nil
+	w.ResponseWriter.WriteHeader(w.code)
+	_, err := w.ResponseWriter.Write(body)
+	return err
+}
+
+// close Writes the buffered body rewritten, or unchanged if it is not valid JSON
+func (w *jsonResponseWriter) close() {
+	if w.buf == nil {
+		return
+	}
+	body := w.buf.Bytes()
+	w.buf = nil
+	var out bytes.Buffer
+	if err := RewriteKeys(&out, bytes.NewReader(body), w.convert); err == nil {
+		body = out.Bytes()
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.ResponseWriter.WriteHeader(w.code)
+	w.ResponseWriter.Write(body)
+}
+
+// RewriteKeys Copies JSON values from r to w with object keys converted, the output is compact.
+// Values are streamed token by token, numbers are copied verbatim, top-level values are separated by newlines.
+// Keys of one object converted to the same name, ex. "userId" and "user_id", return CollisionError,
+// w has the output written before the collision.
+func RewriteKeys(w io.Writer, r io.Reader, convert func(string) string) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	// frames Open containers, the first one is the top level
+	frames := []jsonFrame{{}}
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			if len(frames) > 1 {
+				return io.ErrUnexpectedEOF
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if d, ok := tok.(json.Delim); ok && (d == '}' || d == ']') {
+			frames = frames[:len(frames)-1]
+			if _, err := w.Write([]byte{byte(d)}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		f := &frames[len(frames)-1]
+		isKey := f.object && f.n%2 == 0
+		if f.n > 0 {
+			sep := byte(',')
+			switch {
+			case len(frames) == 1:
+				sep = '\n'
+			case f.object && !isKey:
+				sep = ':'
+			}
+			if _, err := w.Write([]byte{sep}); err != nil {
+				return err
+			}
+		}
+		f.n++
+
+		switch v := tok.(type) {
+		case json.Delim:
+			frames = append(frames, jsonFrame{object: v == '{'})
+			_, err = w.Write([]byte{byte(v)})
+		case string:
+			if isKey {
+				key := v
+				v = convert(v)
+				if f.keys == nil {
+					f.keys = make(map[string]string)
+				}
+				if prev, ok := f.keys[v]; ok && prev != key {
+					return &CollisionError{Key: v, Variants: []string{prev, key}}
+				}
+				f.keys[v] = key
+			}
+			err = writeString(w, v)
+		case json.Number:
+			_, err = io.WriteString(w, string(v))
+		case bool:
+			_, err = io.WriteString(w, strconv.FormatBool(v))
+		case nil:
+			_, err = io.WriteString(w, "null")
+		default:
+			err = fmt.Errorf("httpcase: unexpected token %v", tok)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// jsonFrame Container of RewriteKeys, n counts keys and values written, keys maps new names of object keys to the original keys
+type jsonFrame struct {
+	object bool
+	n      int
+	keys   map[string]string
+}
+
+const hex = "0123456789abcdef"
+
+// writeString Writes s as JSON string, HTML characters are not escaped
+func writeString(w io.Writer, s string) error {
+	buf := make([]byte, 0, len(s)+2)
+	buf = append(buf, '"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			buf = append(buf, '\\', byte(r))
+		case r == '\n':
+			buf = append(buf, '\\', 'n')
+		case r == '\r':
+			buf = append(buf, '\\', 'r')
+		case r == '\t':
+			buf = append(buf, '\\', 't')
+		case r < 0x20 || r == '\u2028' || r == '\u2029':
+			buf = append(buf, '\\', 'u', hex[r>>12&0xf], hex[r>>8&0xf], hex[r>>4&0xf], hex[r&0xf])
+		default:
+			buf = append(buf, string(r)...)
+		}
+	}
+	buf = append(buf, '"')
+	_, err := w.Write(buf)
+	return err
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// matchPaths Path matches a pattern, "/api/" matches the subtree and "/api" only itself. Empty patterns match all paths
+func matchPaths(patterns []string, path string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		if p == path || strings.HasSuffix(p, "/") && strings.HasPrefix(path, p) {
+			return true
+		}
+	}
+	return false
+}
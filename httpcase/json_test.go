@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package httpcase
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/nikitaksv/strcase"
+)
+
+func TestRewriteKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "object", in: `{"userId": 1, "firstName": "Ann"}`, want: `{"user_id":1,"first_name":"Ann"}`},
+		{name: "nested", in: `{"userList": [{"itemId": 1.50e3}, {}], "metaData": {"isActive": true, "nullValue": null}}`,
+			want: `{"user_list":[{"item_id":1.50e3},{}],"meta_data":{"is_active":true,"null_value":null}}`},
+		{name: "string values kept", in: `{"someKey": "someValue", "list": ["camelCase"]}`, want: `{"some_key":"someValue","list":["camelCase"]}`},
+		{name: "escapes", in: `{"quoteKey": "a\"b\\c\n<&>\u0001"}`, want: `{"quote_key":"a\"b\\c\n<&>\u0001"}`},
+		{name: "array", in: `[1, "x", [], {"aB": [null]}]`, want: `[1,"x",[],{"a_b":[null]}]`},
+		{name: "same names in other objects", in: `{"aB": {"aB": 1}, "list": [{"a_b": 2}]}`, want: `{"a_b":{"a_b":1},"list":[{"a_b":2}]}`},
+		{name: "repeated key kept", in: `{"aB": 1, "aB": 2}`, want: `{"a_b":1,"a_b":2}`},
+		{name: "stream", in: "{\"aB\":1}\n{\"cD\":2}", want: "{\"a_b\":1}\n{\"c_d\":2}"},
+		{name: "empty", in: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := RewriteKeys(&buf, strings.NewReader(tt.in), strcase.ToSnakeCase); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("RewriteKeys() = %s, want %s", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestRewriteKeysErrors(t *testing.T) {
+	for _, in := range []string{`{"a":`, `{"a" 1}`, `[1,,2]`, `{"a":1`} {
+		if err := RewriteKeys(ioutil.Discard, strings.NewReader(in), strcase.ToSnakeCase); err == nil {
+			t.Errorf("RewriteKeys(%s) error = nil", in)
+		}
+	}
+}
+
+func TestRewriteKeysCollision(t *testing.T) {
+	err := RewriteKeys(ioutil.Discard, strings.NewReader(`{"item": {"userId": 1, "user_id": 2}}`), strcase.ToSnakeCase)
+	var collision *CollisionError
+	if !errors.As(err, &collision) || collision.Key != "user_id" || !reflect.DeepEqual(collision.Variants, []string{"userId", "user_id"}) {
+		t.Errorf("RewriteKeys() error = %v, want collision of userId and user_id", err)
+	}
+}
+
+func echoHandler(t *testing.T) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		if r.ContentLength != int64(len(body)) {
+			t.Errorf("ContentLength = %d, want %d", r.ContentLength, len(body))
+		}
+		w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		w.Header().Set("X-Request-Body", string(body))
+		w.Write(body)
+	})
+}
+
+func TestJSONHandler(t *testing.T) {
+	h := JSONHandler(echoHandler(t), JSONConfig{
+		Request:     strcase.ToSnakeCase,
+		Response:    strcase.ToCamelCase,
+		Paths:       []string{"/api/", "/health"},
+		MaxBodySize: 64,
+	})
+	tests := []struct {
+		name        string
+		path        string
+		contentType string
+		body        string
+		wantCode    int
+		wantRequest string
+		wantBody    string
+	}{
+		{name: "rewrite", path: "/api/users", contentType: "application/json", body: `{"userId": 1}`,
+			wantCode: 200, wantRequest: `{"user_id":1}`, wantBody: `{"userId":1}`},
+		{name: "json suffix", path: "/health", contentType: "application/problem+json; charset=utf-8", body: `{"errorCode": 2}`,
+			wantCode: 200, wantRequest: `{"error_code":2}`, wantBody: `{"errorCode":2}`},
+		{name: "not json", path: "/api/users", contentType: "text/plain", body: `{"userId": 1}`,
+			wantCode: 200, wantRequest: `{"userId": 1}`, wantBody: `{"userId": 1}`},
+		{name: "not allowed path", path: "/other", contentType: "application/json", body: `{"userId": 1}`,
+			wantCode: 200, wantRequest: `{"userId": 1}`, wantBody: `{"userId": 1}`},
+		{name: "subtree only", path: "/health/x", contentType: "application/json", body: `{"userId": 1}`,
+			wantCode: 200, wantRequest: `{"userId": 1}`, wantBody: `{"userId": 1}`},
+		{name: "invalid", path: "/api/users", contentType: "application/json", body: `{"userId": `,
+			wantCode: 400},
+		{name: "colliding keys", path: "/api/users", contentType: "application/json", body: `{"userId": 1, "user_id": 2}`,
+			wantCode: 400},
+		{name: "too large", path: "/api/users", contentType: "application/json", body: `{"userId": "` + strings.Repeat("x", 64) + `"}`,
+			wantCode: 413},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", tt.contentType)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			if w.Code != tt.wantCode {
+				t.Fatalf("code = %d, want %d", w.Code, tt.wantCode)
+			}
+			if tt.wantCode != 200 {
+				return
+			}
+			if got := w.Header().Get("X-Request-Body"); got != tt.wantRequest {
+				t.Errorf("request body = %s, want %s", got, tt.wantRequest)
+			}
+			if got := w.Body.String(); got != tt.wantBody {
+				t.Errorf("response body = %s, want %s", got, tt.wantBody)
+			}
+			if cl := w.Header().Get("Content-Length"); cl != "" && cl != strconv.Itoa(w.Body.Len()) {
+				t.Errorf("Content-Length = %s, body length %d", cl, w.Body.Len())
+			}
+		})
+	}
+}
+
+func TestJSONHandlerChunkedWrites(t *testing.T) {
+	h := JSONHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"itemList": [`)
+		for i := 0; i < 3; i++ {
+			if i > 0 {
+				io.WriteString(w, ",")
+			}
+			io.WriteString(w, `{"itemId": `+strconv.Itoa(i)+`}`)
+		}
+		io.WriteString(w, `]}`)
+	}), JSONConfig{Response: strcase.ToSnakeCase})
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"item_list":[{"item_id":0},{"item_id":1},{"item_id":2}]}`
+	if string(body) != want {
+		t.Errorf("body = %s, want %s", body, want)
+	}
+}
+
+func TestJSONHandlerLargeResponse(t *testing.T) {
+	body := `{"userId": "` + strings.Repeat("x", 16) + `"}`
+	h := JSONHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		io.WriteString(w, body)
+	}), JSONConfig{Response: strcase.ToSnakeCase, MaxBodySize: 16})
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	if w.Body.String() != body {
+		t.Errorf("body = %s, want unchanged %s", w.Body.String(), body)
+	}
+	if w.Header().Get("Content-Length") != strconv.Itoa(len(body)) {
+		t.Errorf("Content-Length = %s, want %d", w.Header().Get("Content-Length"), len(body))
+	}
+}
+
+func TestJSONHandlerInvalidResponse(t *testing.T) {
+	for _, body := range []string{`{"user_id": 1, "bad`, `not json at all`, `{"user_id":1,"userId":2}`, `{"userId": "` + strings.Repeat("x", 32) + `"}`} {
+		h := JSONHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			for i := 0; i < len(body); i += 8 {
+				end := i + 8
+				if end > len(body) {
+					end = len(body)
+				}
+				if _, err := io.WriteString(w, body[i:end]); err != nil {
+					t.Errorf("Write() error = %v", err)
+				}
+			}
+		}), JSONConfig{Response: strcase.ToCamelCase, MaxBodySize: 32})
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+		if w.Body.String() != body {
+			t.Errorf("body = %s, want unchanged %s", w.Body.String(), body)
+		}
+	}
+}
+
+func TestJSONHandlerFlush(t *testing.T) {
+	h := JSONHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"userId": 1,`)
+		w.(http.Flusher).Flush()
+		io.WriteString(w, ` "userName": "bob"}`)
+	}), JSONConfig{Response: strcase.ToSnakeCase})
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	if want := `{"userId": 1, "userName": "bob"}`; w.Body.String() != want || !w.Flushed {
+		t.Errorf("body = %s, flushed %v, want unchanged %s", w.Body.String(), w.Flushed, want)
+	}
+	if _, ok := interface{}(&jsonResponseWriter{}).(http.Hijacker); !ok {
+		t.Errorf("jsonResponseWriter does not implement http.Hijacker")
+	}
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package httpcase
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// CollisionPolicy Resolution of keys converted to the same name. Ex. "pageSize" and "page_size"
+type CollisionPolicy int
+
+const (
+	// CollisionMerge Values of all keys, the canonical key first and other keys in sorted order
+	CollisionMerge CollisionPolicy = iota
+	// CollisionCanonical Values of the key already in the canonical case, else of the first key in sorted order
+	CollisionCanonical
+	// CollisionReject CollisionError
+	CollisionReject
+)
+
+// CollisionError Several keys are converted to the same name under CollisionReject
+type CollisionError struct {
+	Key      string
+	Variants []string
+}
+
+func (e *CollisionError) Error() string {
+	return fmt.Sprintf("httpcase: keys %s collide as %q", strings.Join(e.Variants, ", "), e.Key)
+}
+
+// NormalizeValues Values with keys converted by convert, values of colliding keys are resolved by policy.
+// Ex. NormalizeValues(url.Values{"pageSize": {"10"}}, strcase.ToSnakeCase, CollisionMerge) -> {"page_size": {"10"}}
+func NormalizeValues(values url.Values, convert func(string) string, policy CollisionPolicy) (url.Values, error) {
+	variants := make(map[string][]string, len(values))
+	for key := range values {
+		name := convert(key)
+		variants[name] = append(variants[name], key)
+	}
+
+	normalized := make(url.Values, len(variants))
+	for name, keys := range variants {
+		sort.Slice(keys, func(i, j int) bool {
+			if (keys[i] == name) != (keys[j] == name) {
+				return keys[i] == name
+			}
+			return keys[i] < keys[j]
+		})
+		if len(keys) > 1 {
+			switch policy {
+			case CollisionReject:
+				return nil, &CollisionError{Key: name, Variants: keys}
+			case CollisionCanonical:
+				keys = keys[:1]
+			}
+		}
+		var vs []string
+		for _, key := range keys {
+			vs = append(vs, values[key]...)
+		}
+		normalized[name] = vs
+	}
+	return normalized, nil
+}
+
+// QueryConfig Key conversion of QueryHandler
+type QueryConfig struct {
+	// Convert Converter of keys. Ex. strcase.ToSnakeCase
+	Convert func(string) string
+	// Policy Resolution of colliding keys
+	Policy CollisionPolicy
+	// MaxMemory Memory limit of multipart forms, DefaultMaxMemory if 0
+	MaxMemory int64
+}
+
+// DefaultMaxMemory Default QueryConfig.MaxMemory, as used by http.Request.FormValue
+const DefaultMaxMemory = 32 << 20
+
+// QueryHandler Normalizes keys of the query string, of url-encoded and of multipart forms before h is called.
+// r.URL.RawQuery is re-encoded, r.Form, r.PostForm and r.MultipartForm.Value are parsed if needed and normalized,
+// keys of r.MultipartForm.File are kept. CollisionReject errors are rejected with 400.
+func QueryHandler(h http.Handler, cfg QueryConfig) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := normalizeRequest(r, cfg); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func normalizeRequest(r *http.Request, cfg QueryConfig) error {
+	query, err := url.ParseQuery(r.URL.RawQuery)
+	if err != nil {
+		return err
+	}
+	if query, err = NormalizeValues(query, cfg.Convert, cfg.Policy); err != nil {
+		return err
+	}
+	r.URL.RawQuery = query.Encode()
+
+	maxMemory := cfg.MaxMemory
+	if maxMemory == 0 {
+		maxMemory = DefaultMaxMemory
+	}
+	// Forms may be parsed already, ParseMultipartForm parses url-encoded forms too
+	if r.MultipartForm == nil {
+		if err := r.ParseMultipartForm(maxMemory); err != nil && err != http.ErrNotMultipart {
+			return err
+		}
+	}
+	if r.MultipartForm != nil {
+		if r.MultipartForm.Value, err = NormalizeValues(r.MultipartForm.Value, cfg.Convert, cfg.Policy); err != nil {
+			return err
+		}
+	}
+	if r.PostForm, err = NormalizeValues(r.PostForm, cfg.Convert, cfg.Policy); err != nil {
+		return err
+	}
+	// Form Post values first as in http.Request.ParseForm, multipart values are part of PostForm
+	r.Form = make(url.Values, len(r.PostForm)+len(query))
+	for k, vs := range r.PostForm {
+		r.Form[k] = append(r.Form[k], vs...)
+	}
+	for k, vs := range query {
+		r.Form[k] = append(r.Form[k], vs...)
+	}
+	return nil
+}
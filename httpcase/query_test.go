@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package httpcase
+
+import (
+	"bytes"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nikitaksv/strcase"
+)
+
+func TestNormalizeValues(t *testing.T) {
+	values := url.Values{
+		"pageSize":  {"10"},
+		"page-size": {"20"},
+		"page_size": {"30"},
+		"sortBy":    {"name", "id"},
+	}
+	tests := []struct {
+		policy CollisionPolicy
+		want   url.Values
+	}{
+		{policy: CollisionMerge, want: url.Values{"page_size": {"30", "20", "10"}, "sort_by": {"name", "id"}}},
+		{policy: CollisionCanonical, want: url.Values{"page_size": {"30"}, "sort_by": {"name", "id"}}},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeValues(values, strcase.ToSnakeCase, tt.policy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("NormalizeValues(%d) = %v, want %v", tt.policy, got, tt.want)
+		}
+	}
+
+	got, err := NormalizeValues(url.Values{"pageSize": {"1"}, "page-size": {"2"}}, strcase.ToSnakeCase, CollisionCanonical)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (url.Values{"page_size": {"2"}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("NormalizeValues() without canonical key = %v, want %v", got, want)
+	}
+
+	_, err = NormalizeValues(values, strcase.ToSnakeCase, CollisionReject)
+	var collision *CollisionError
+	if !errors.As(err, &collision) {
+		t.Fatalf("NormalizeValues() error = %v, want CollisionError", err)
+	}
+	if want := (&CollisionError{Key: "page_size", Variants: []string{"page_size", "page-size", "pageSize"}}); !reflect.DeepEqual(collision, want) {
+		t.Errorf("CollisionError = %+v, want %+v", collision, want)
+	}
+}
+
+func TestQueryHandler(t *testing.T) {
+	var got *http.Request
+	h := QueryHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+	}), QueryConfig{Convert: strcase.ToSnakeCase})
+
+	r := httptest.NewRequest(http.MethodPost, "/items?pageSize=10&sort-by=name", strings.NewReader("filterName=a&page-size=20"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("code = %d, want 200", w.Code)
+	}
+	if want := "page_size=10&sort_by=name"; got.URL.RawQuery != want {
+		t.Errorf("RawQuery = %s, want %s", got.URL.RawQuery, want)
+	}
+	if want := (url.Values{"filter_name": {"a"}, "page_size": {"20"}}); !reflect.DeepEqual(got.PostForm, want) {
+		t.Errorf("PostForm = %v, want %v", got.PostForm, want)
+	}
+	if want := (url.Values{"filter_name": {"a"}, "page_size": {"20", "10"}, "sort_by": {"name"}}); !reflect.DeepEqual(got.Form, want) {
+		t.Errorf("Form = %v, want %v", got.Form, want)
+	}
+	if v := got.FormValue("page_size"); v != "20" {
+		t.Errorf("FormValue() = %s, want 20", v)
+	}
+}
+
+func TestQueryHandlerMultipart(t *testing.T) {
+	var got *http.Request
+	h := QueryHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+	}), QueryConfig{Convert: strcase.ToSnakeCase})
+
+	body := &bytes.Buffer{}
+	mw := multipart.NewWriter(body)
+	if err := mw.WriteField("filterName", "a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := mw.WriteField("page-size", "20"); err != nil {
+		t.Fatal(err)
+	}
+	if err := mw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	r := httptest.NewRequest(http.MethodPost, "/items?pageSize=10", body)
+	r.Header.Set("Content-Type", mw.FormDataContentType())
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("code = %d, want 200", w.Code)
+	}
+	want := map[string][]string{"filter_name": {"a"}, "page_size": {"20"}}
+	if !reflect.DeepEqual(got.MultipartForm.Value, want) {
+		t.Errorf("MultipartForm.Value = %v, want %v", got.MultipartForm.Value, want)
+	}
+	if !reflect.DeepEqual(got.PostForm, url.Values(want)) {
+		t.Errorf("PostForm = %v, want %v", got.PostForm, want)
+	}
+	if want := (url.Values{"filter_name": {"a"}, "page_size": {"20", "10"}}); !reflect.DeepEqual(got.Form, want) {
+		t.Errorf("Form = %v, want %v", got.Form, want)
+	}
+}
+
+func TestQueryHandlerParsedForm(t *testing.T) {
+	var got *http.Request
+	h := QueryHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+	}), QueryConfig{Convert: strcase.ToSnakeCase})
+
+	r := httptest.NewRequest(http.MethodPost, "/items?sort-by=name", strings.NewReader("filterName=a"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if err := r.ParseForm(); err != nil {
+		t.Fatal(err)
+	}
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	if want := (url.Values{"filter_name": {"a"}}); !reflect.DeepEqual(got.PostForm, want) {
+		t.Errorf("PostForm = %v, want %v", got.PostForm, want)
+	}
+	if want := (url.Values{"filter_name": {"a"}, "sort_by": {"name"}}); !reflect.DeepEqual(got.Form, want) {
+		t.Errorf("Form = %v, want %v", got.Form, want)
+	}
+}
+
+func TestQueryHandlerReject(t *testing.T) {
+	h := QueryHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("handler called on collision")
+	}), QueryConfig{Convert: strcase.ToSnakeCase, Policy: CollisionReject})
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/items?pageSize=10&page_size=20", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("code = %d, want 400", w.Code)
+	}
+}
@@ -0,0 +1,292 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+type inflectionRule struct {
+	re          *regexp.Regexp
+	replacement string
+}
+
+// inflections English inflection rules. Rules added later take precedence.
+var inflections = struct {
+	sync.RWMutex
+	plurals      []inflectionRule
+	singulars    []inflectionRule
+	irregulars   map[string]string // singular -> plural
+	irregularsR  map[string]string // plural -> singular
+	uncountables map[string]bool
+}{
+	irregulars:   map[string]string{},
+	irregularsR:  map[string]string{},
+	uncountables: map[string]bool{},
+}
+
+var _basePlurals = [][2]string{
+	{`$`, `s`},
+	{`s$`, `s`},
+	{`^(ax|test)is$`, `${1}es`},
+	{`(octop|vir)us$`, `${1}i`},
+	{`(octop|vir)i$`, `${1}i`},
+	{`(alias|status)$`, `${1}es`},
+	{`(bu)s$`, `${1}ses`},
+	{`(buffal|tomat)o$`, `${1}oes`},
+	{`([ti])um$`, `${1}a`},
+	{`([ti])a$`, `${1}a`},
+	{`sis$`, `ses`},
+	{`(?:([^f])fe|([lr])f)$`, `${1}${2}ves`},
+	{`(hive)$`, `${1}s`},
+	{`([^aeiouy]|qu)y$`, `${1}ies`},
+	{`(x|ch|ss|sh)$`, `${1}es`},
+	{`(matr|vert|ind)(?:ix|ex)$`, `${1}ices`},
+	{`^(m|l)ouse$`, `${1}ice`},
+	{`^(m|l)ice$`, `${1}ice`},
+	{`^(ox)$`, `${1}en`},
+	{`^(oxen)$`, `${1}`},
+	{`(quiz)$`, `${1}zes`},
+}
+
+var _baseSingulars = [][2]string{
+	{`s$`, ``},
+	{`(ss)$`, `${1}`},
+	{`(n)ews$`, `${1}ews`},
+	{`([ti])a$`, `${1}um`},
+	{`((a)naly|(b)a|(d)iagno|(p)arenthe|(p)rogno|(s)ynop|(t)he)(sis|ses)$`, `${1}sis`},
+	{`(^analy)(sis|ses)$`, `${1}sis`},
+	{`([^f])ves$`, `${1}fe`},
+	{`(hive)s$`, `${1}`},
+	{`(tive)s$`, `${1}`},
+	{`([lr])ves$`, `${1}f`},
+	{`([^aeiouy]|qu)ies$`, `${1}y`},
+	{`(s)eries$`, `${1}eries`},
+	{`(m)ovies$`, `${1}ovie`},
+	{`(x|ch|ss|sh)es$`, `${1}`},
+	{`^(m|l)ice$`, `${1}ouse`},
+	{`(bus)(es)?$`, `${1}`},
+	{`(o)es$`, `${1}`},
+	{`(shoe)s$`, `${1}`},
+	{`(cris|test)(is|es)$`, `${1}is`},
+	{`^(a)x[ie]s$`, `${1}xis`},
+	{`(octop|vir)(us|i)$`, `${1}us`},
+	{`(alias|status)(es)?$`, `${1}`},
+	{`^(ox)en`, `${1}`},
+	{`(vert|ind)ices$`, `${1}ex`},
+	{`(matr)ices$`, `${1}ix`},
+	{`(quiz)zes$`, `${1}`},
+	{`(database)s$`, `${1}`},
+}
+
+var _baseIrregulars = [][2]string{
+	{"person", "people"},
+	{"man", "men"},
+	{"woman", "women"},
+	{"child", "children"},
+	{"sex", "sexes"},
+	{"move", "moves"},
+	{"zombie", "zombies"},
+	{"foot", "feet"},
+	{"tooth", "teeth"},
+	{"goose", "geese"},
+}
+
+var _baseUncountables = []string{
+	"equipment",
+	"information",
+	"rice",
+	"money",
+	"species",
+	"series",
+	"fish",
+	"sheep",
+	"jeans",
+	"police",
+	"metadata",
+	"data",
+	"news",
+}
+
+func init() {
+	for _, rule := range _basePlurals {
+		AddPluralRule(regexp.MustCompile(rule[0]), rule[1])
+	}
+	for _, rule := range _baseSingulars {
+		AddSingularRule(regexp.MustCompile(rule[0]), rule[1])
+	}
+	for _, irr := range _baseIrregulars {
+		AddIrregular(irr[0], irr[1])
+	}
+	AddUncountable(_baseUncountables...)
+}
+
+// AddPluralRule Add pluralization rule, replacement may reference groups of rule as ${1}.
+// Rules match lowercase words and take precedence over previously added rules.
+func AddPluralRule(rule *regexp.Regexp, replacement string) {
+	inflections.Lock()
+	inflections.plurals = append(inflections.plurals, inflectionRule{re: rule, replacement: replacement})
+	inflections.Unlock()
+}
+
+// AddSingularRule Add singularization rule, replacement may reference groups of rule as ${1}.
+// Rules match lowercase words and take precedence over previously added rules.
+func AddSingularRule(rule *regexp.Regexp, replacement string) {
+	inflections.Lock()
+	inflections.singulars = append(inflections.singulars, inflectionRule{re: rule, replacement: replacement})
+	inflections.Unlock()
+}
+
+// AddIrregular Add irregular word. Ex. AddIrregular("person", "people")
+func AddIrregular(singular, plural string) {
+	singular, plural = strings.ToLower(singular), strings.ToLower(plural)
+	inflections.Lock()
+	delete(inflections.uncountables, singular)
+	delete(inflections.uncountables, plural)
+	inflections.irregulars[singular] = plural
+	inflections.irregularsR[plural] = singular
+	inflections.Unlock()
+}
+
+// AddUncountable Add words which have no plural form. Ex. AddUncountable("equipment")
+func AddUncountable(words ...string) {
+	inflections.Lock()
+	for _, w := range words {
+		inflections.uncountables[strings.ToLower(w)] = true
+	}
+	inflections.Unlock()
+}
+
+// Pluralize Pluralizes last word of identifier preserving its style. Ex. "OrderItem" -> "OrderItems", "UserID" -> "UserIDs"
+func Pluralize(str string) string {
+	return string(PluralizeRunes([]rune(str)))
+}
+
+// Singularize Singularizes last word of identifier preserving its style. Ex. "order_items" -> "order_item", "People" -> "Person"
+func Singularize(str string) string {
+	return string(SingularizeRunes([]rune(str)))
+}
+
+// PluralizeRunes Pluralizes last word of slice of runes preserving its style
+func PluralizeRunes(runes []rune) []rune {
+	return inflectRunes(runes, true)
+}
+
+// SingularizeRunes Singularizes last word of slice of runes preserving its style
+func SingularizeRunes(runes []rune) []rune {
+	return inflectRunes(runes, false)
+}
+
+func inflectRunes(runes []rune, plural bool) []rune {
+	words := ParseRunes(runes)
+	if len(words) == 0 {
+		return runes
+	}
+	last := words[len(words)-1]
+
+	end := len(runes)
+	for end > 0 && isDelimiter(runes[end-1]) && !unicode.IsLetter(runes[end-1]) {
+		end--
+	}
+	start := end - len(last)
+	word := runes[start:end]
+
+	var inflected []rune
+	switch {
+	case !hasLower(runes) && !(len(words) == 1 && isKnownAcronym(word)):
+		// SCREAMING_SNAKE, a single known acronym keeps "s": "ID" -> "IDs"
+		inflected = toUpperRunes([]rune(inflectWord(string(last), plural)))
+	case len(word) > 1 && isAcronymWord(word):
+		inflected = inflectAcronym(word, string(last), plural)
+	default:
+		inflected = []rune(inflectWord(string(last), plural))
+		if unicode.IsUpper(word[0]) || unicode.IsTitle(word[0]) {
+			inflected = toTitleRunes(inflected)
+		}
+	}
+
+	res := make([]rune, 0, len(runes)+len(inflected)-len(word))
+	res = append(res, runes[:start]...)
+	res = append(res, inflected...)
+	return append(res, runes[end:]...)
+}
+
+// inflectWord Inflects lowercase word
+func inflectWord(word string, plural bool) string {
+	inflections.RLock()
+	defer inflections.RUnlock()
+
+	if inflections.uncountables[word] {
+		return word
+	}
+	rules, irregulars, irregularsR := inflections.singulars, inflections.irregularsR, inflections.irregulars
+	if plural {
+		rules, irregulars, irregularsR = inflections.plurals, inflections.irregulars, inflections.irregularsR
+	}
+	if w, ok := irregulars[word]; ok {
+		return w
+	}
+	if _, ok := irregularsR[word]; ok {
+		return word
+	}
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].re.MatchString(word) {
+			return rules[i].re.ReplaceAllString(word, rules[i].replacement)
+		}
+	}
+	return word
+}
+
+// isAcronymWord Word is written as acronym: "ID", "IDs", "URLs"
+func isAcronymWord(word []rune) bool {
+	if isAllUpper(word) || len(word) > 2 && isAllUpper(word[:len(word)-1]) && word[len(word)-1] == 's' {
+		return true
+	}
+	return isKnownAcronym(word)
+}
+
+// isKnownAcronym Word is an added acronym written as is: "ID", "ETag"
+func isKnownAcronym(word []rune) bool {
+	acr, found := ReplaceAcronymRunes(toLowerRunes(append([]rune{}, word...)))
+	return found && string(acr) == string(word)
+}
+
+func inflectAcronym(word []rune, lower string, plural bool) []rune {
+	if acr, found := ReplaceAcronymRunes([]rune(inflectWord(lower, plural))); found {
+		return acr
+	}
+	isPlural := word[len(word)-1] == 's'
+	if plural && !isPlural {
+		return append(append([]rune{}, word...), 's')
+	}
+	if !plural && isPlural {
+		return word[:len(word)-1]
+	}
+	return word
+}
+
+func hasLower(runes []rune) bool {
+	for _, r := range runes {
+		if unicode.IsLower(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestPluralize(t *testing.T) {
+	type args struct {
+		str string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{name: "word", args: args{str: "item"}, want: "items"},
+		{name: "PascalCase", args: args{str: "OrderItem"}, want: "OrderItems"},
+		{name: "snake_case", args: args{str: "order_item"}, want: "order_items"},
+		{name: "kebab-case", args: args{str: "order-item"}, want: "order-items"},
+		{name: "SCREAMING_SNAKE", args: args{str: "ORDER_ITEM"}, want: "ORDER_ITEMS"},
+		{name: "irregular", args: args{str: "Person"}, want: "People"},
+		{name: "irregular last word", args: args{str: "SalesPerson"}, want: "SalesPeople"},
+		{name: "irregular plural", args: args{str: "people"}, want: "people"},
+		{name: "uncountable", args: args{str: "UserEquipment"}, want: "UserEquipment"},
+		{name: "acronym", args: args{str: "UserID"}, want: "UserIDs"},
+		{name: "acronym snake", args: args{str: "user_ID"}, want: "user_IDs"},
+		{name: "unknown acronym", args: args{str: "ProfileURL"}, want: "ProfileURLs"},
+		{name: "single acronym", args: args{str: "ID"}, want: "IDs"},
+		{name: "single acronym URL", args: args{str: "URL"}, want: "URLs"},
+		{name: "SCREAMING word", args: args{str: "ITEM"}, want: "ITEMS"},
+		{name: "SCREAMING acronym last", args: args{str: "USER_ID"}, want: "USER_IDS"},
+		{name: "camelCase Id", args: args{str: "userId"}, want: "userIds"},
+		{name: "y", args: args{str: "category"}, want: "categories"},
+		{name: "ves", args: args{str: "wife"}, want: "wives"},
+		{name: "es", args: args{str: "Box"}, want: "Boxes"},
+		{name: "status", args: args{str: "OrderStatus"}, want: "OrderStatuses"},
+		{name: "sis", args: args{str: "analysis"}, want: "analyses"},
+		{name: "matrix", args: args{str: "matrix"}, want: "matrices"},
+		{name: "trailing separator", args: args{str: "order_item_"}, want: "order_items_"},
+		{name: "empty", args: args{str: ""}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Pluralize(tt.args.str); got != tt.want {
+				t.Errorf("Pluralize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSingularize(t *testing.T) {
+	type args struct {
+		str string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{name: "word", args: args{str: "items"}, want: "item"},
+		{name: "PascalCase", args: args{str: "OrderItems"}, want: "OrderItem"},
+		{name: "snake_case", args: args{str: "order_items"}, want: "order_item"},
+		{name: "SCREAMING_SNAKE", args: args{str: "ORDER_ITEMS"}, want: "ORDER_ITEM"},
+		{name: "irregular", args: args{str: "People"}, want: "Person"},
+		{name: "irregular singular", args: args{str: "person"}, want: "person"},
+		{name: "uncountable", args: args{str: "sheep"}, want: "sheep"},
+		{name: "acronym", args: args{str: "UserIDs"}, want: "UserID"},
+		{name: "unknown acronym", args: args{str: "ProfileURLs"}, want: "ProfileURL"},
+		{name: "single acronym", args: args{str: "IDs"}, want: "ID"},
+		{name: "ies", args: args{str: "categories"}, want: "category"},
+		{name: "ves", args: args{str: "wolves"}, want: "wolf"},
+		{name: "ses", args: args{str: "statuses"}, want: "status"},
+		{name: "ss", args: args{str: "address"}, want: "address"},
+		{name: "ices", args: args{str: "vertices"}, want: "vertex"},
+		{name: "database", args: args{str: "databases"}, want: "database"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Singularize(tt.args.str); got != tt.want {
+				t.Errorf("Singularize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddInflections(t *testing.T) {
+	AddIrregular("cactus", "cacti")
+	AddUncountable("firmware")
+	AddPluralRule(regexp.MustCompile(`(cherub)$`), "${1}im")
+	AddSingularRule(regexp.MustCompile(`(cherub)im$`), "${1}")
+
+	tests := []struct {
+		name string
+		f    func(string) string
+		str  string
+		want string
+	}{
+		{name: "irregular plural", f: Pluralize, str: "BigCactus", want: "BigCacti"},
+		{name: "irregular singular", f: Singularize, str: "big_cacti", want: "big_cactus"},
+		{name: "uncountable", f: Pluralize, str: "DeviceFirmware", want: "DeviceFirmware"},
+		{name: "plural rule", f: Pluralize, str: "GoldCherub", want: "GoldCherubim"},
+		{name: "singular rule", f: Singularize, str: "gold_cherubim", want: "gold_cherub"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f(tt.str); got != tt.want {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"strings"
+)
+
+// Tableize Table name of class name, "::" of modules becomes "/". Ex. "RawScaledScorer" -> "raw_scaled_scorers", "Admin::User" -> "admin/users"
+func Tableize(str string) string {
+	modules := strings.Split(strings.TrimPrefix(str, "::"), "::")
+	for i, m := range modules {
+		modules[i] = ToSnakeCase(m)
+	}
+	modules[len(modules)-1] = Pluralize(modules[len(modules)-1])
+	return strings.Join(modules, "/")
+}
+
+// Classify Class name of table name, schema prefix is removed. Ex. "egg_and_hams" -> "EggAndHam", "schema.posts" -> "Post"
+func Classify(str string) string {
+	if i := strings.LastIndexByte(str, SeparatorDot); i >= 0 {
+		str = str[i+1:]
+	}
+	return ToPascalCaseAcronym(Singularize(str))
+}
+
+// Humanize Human readable attribute name, trailing "id" word and "::" module path are removed.
+// Ex. "employee_salary" -> "Employee salary", "author_id" -> "Author", "Admin::User" -> "User"
+func Humanize(str string) string {
+	if i := strings.LastIndex(str, "::"); i >= 0 {
+		str = str[i+2:]
+	}
+	words := ParseRunes([]rune(strings.TrimLeft(str, string(SeparatorUnderscore))))
+	if len(words) > 1 && string(words[len(words)-1]) == "id" {
+		words = words[:len(words)-1]
+	}
+	for i, w := range words {
+		if acr, found := ReplaceAcronymRunes(w); found {
+			words[i] = acr
+		} else if i == 0 {
+			words[i] = toTitleRunes(w)
+		}
+	}
+	return string(concatRuneWords(words, []rune{' '}))
+}
+
+// ForeignKey Foreign key name of class name. Ex. "Message" -> "message_id", "Admin::Post" -> "post_id"
+func ForeignKey(str string) string {
+	return ToSnakeCase(Demodulize(str)) + "_id"
+}
+
+// Demodulize Removes module part of the path. Ex. "ActiveSupport::Inflector::Inflections" -> "Inflections", "models.OrderItem" -> "OrderItem"
+func Demodulize(str string) string {
+	if i, sep := lastModuleSeparator(str); i >= 0 {
+		return str[i+len(sep):]
+	}
+	return str
+}
+
+// Deconstantize Removes rightmost segment of the path. Ex. "Net::HTTP" -> "Net", "models.OrderItem" -> "models"
+func Deconstantize(str string) string {
+	if i, _ := lastModuleSeparator(str); i >= 0 {
+		return str[:i]
+	}
+	return ""
+}
+
+// lastModuleSeparator Index of the last "::" or "." in str
+func lastModuleSeparator(str string) (int, string) {
+	i, sep := strings.LastIndex(str, "::"), "::"
+	if j := strings.LastIndexByte(str, SeparatorDot); j > i {
+		i, sep = j, string(SeparatorDot)
+	}
+	return i, sep
+}
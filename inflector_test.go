@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"testing"
+)
+
+func TestInflector(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(string) string
+		str  string
+		want string
+	}{
+		{name: "Tableize", f: Tableize, str: "RawScaledScorer", want: "raw_scaled_scorers"},
+		{name: "Tableize", f: Tableize, str: "egg_and_ham", want: "egg_and_hams"},
+		{name: "Tableize", f: Tableize, str: "fancyCategory", want: "fancy_categories"},
+		{name: "Tableize", f: Tableize, str: "OrderItem", want: "order_items"},
+		{name: "Tableize", f: Tableize, str: "Person", want: "people"},
+		{name: "Tableize", f: Tableize, str: "Admin::User", want: "admin/users"},
+		{name: "Tableize", f: Tableize, str: "::Admin::UserRole", want: "admin/user_roles"},
+		{name: "Classify", f: Classify, str: "egg_and_hams", want: "EggAndHam"},
+		{name: "Classify", f: Classify, str: "posts", want: "Post"},
+		{name: "Classify", f: Classify, str: "calculus", want: "Calculu"},
+		{name: "Classify", f: Classify, str: "schema.posts", want: "Post"},
+		{name: "Classify", f: Classify, str: "order_items", want: "OrderItem"},
+		{name: "Classify", f: Classify, str: "user_ids", want: "UserID"},
+		{name: "Humanize", f: Humanize, str: "employee_salary", want: "Employee salary"},
+		{name: "Humanize", f: Humanize, str: "author_id", want: "Author"},
+		{name: "Humanize", f: Humanize, str: "_author_name", want: "Author name"},
+		{name: "Humanize", f: Humanize, str: "json_data", want: "JSON data"},
+		{name: "Humanize", f: Humanize, str: "Admin::User", want: "User"},
+		{name: "Humanize", f: Humanize, str: "Admin::AuthorID", want: "Author"},
+		{name: "ForeignKey", f: ForeignKey, str: "Message", want: "message_id"},
+		{name: "ForeignKey", f: ForeignKey, str: "Admin::Post", want: "post_id"},
+		{name: "ForeignKey", f: ForeignKey, str: "models.OrderItem", want: "order_item_id"},
+		{name: "Demodulize", f: Demodulize, str: "ActiveSupport::Inflector::Inflections", want: "Inflections"},
+		{name: "Demodulize", f: Demodulize, str: "Inflections", want: "Inflections"},
+		{name: "Demodulize", f: Demodulize, str: "::Inflections", want: "Inflections"},
+		{name: "Demodulize", f: Demodulize, str: "", want: ""},
+		{name: "Demodulize", f: Demodulize, str: "models.OrderItem", want: "OrderItem"},
+		{name: "Deconstantize", f: Deconstantize, str: "Net::HTTP", want: "Net"},
+		{name: "Deconstantize", f: Deconstantize, str: "::Net::HTTP", want: "::Net"},
+		{name: "Deconstantize", f: Deconstantize, str: "String", want: ""},
+		{name: "Deconstantize", f: Deconstantize, str: "::String", want: ""},
+		{name: "Deconstantize", f: Deconstantize, str: "", want: ""},
+		{name: "Deconstantize", f: Deconstantize, str: "github.models.OrderItem", want: "github.models"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"("+tt.str+")", func(t *testing.T) {
+			if got := tt.f(tt.str); got != tt.want {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
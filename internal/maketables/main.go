@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command maketables generates translit_tables.go from the canonical decompositions of UnicodeData.txt.
+// Compatibility decompositions (digraphs such as ǆ, ligatures such as ﬁ) are skipped,
+// their transliterations are listed in the transliterations table of translit.go.
+// Run go generate in the strcase package directory.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var (
+	data   = flag.String("data", "https://www.unicode.org/Public/14.0.0/ucd/UnicodeData.txt", "URL or path of UnicodeData.txt")
+	output = flag.String("output", "translit_tables.go", "output file")
+)
+
+// ranges Blocks whose precomposed letters are folded: Latin, Greek, Cyrillic.
+var ranges = [][2]rune{
+	{0x00C0, 0x024F},
+	{0x0370, 0x03FF},
+	{0x0400, 0x04FF},
+	{0x1E00, 0x1EFF},
+	{0x1F00, 0x1FFF},
+}
+
+func main() {
+	flag.Parse()
+
+	r, err := open(*data)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Close()
+
+	decomposition := map[rune]rune{}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		fields := strings.Split(s.Text(), ";")
+		if len(fields) < 6 || fields[5] == "" || strings.HasPrefix(fields[5], "<") {
+			continue
+		}
+		cp, err := strconv.ParseUint(fields[0], 16, 32)
+		if err != nil {
+			log.Fatal(err)
+		}
+		first, err := strconv.ParseUint(strings.Fields(fields[5])[0], 16, 32)
+		if err != nil {
+			log.Fatal(err)
+		}
+		decomposition[rune(cp)] = rune(first)
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintln(buf, "// Code generated by internal/maketables; DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package strcase")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "// decompositionBase Base rune of the full canonical decomposition of precomposed runes.")
+	fmt.Fprintln(buf, "var decompositionBase = map[rune]rune{")
+	for _, rng := range ranges {
+		for cp := rng[0]; cp <= rng[1]; cp++ {
+			base, ok := decomposition[cp]
+			if !ok {
+				continue
+			}
+			for {
+				next, ok := decomposition[base]
+				if !ok {
+					break
+				}
+				base = next
+			}
+			fmt.Fprintf(buf, "\t0x%04X: 0x%04X, // %c -> %c\n", cp, base, cp, base)
+		}
+	}
+	fmt.Fprintln(buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func open(name string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(name, "http://") && !strings.HasPrefix(name, "https://") {
+		return os.Open(name)
+	}
+	resp, err := http.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("get %s: %s", name, resp.Status)
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mapper maps database/sql columns to struct fields by strcase conversion of field names.
+//
+//	type User struct {
+//		ID        int64
+//		CreatedAt time.Time        // created_at
+//		Name      string `db:"login"`
+//	}
+//
+//	var users []User
+//	err := mapper.ScanAll(rows, &users)
+package mapper
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/nikitaksv/strcase"
+)
+
+// DefaultTag Tag with column names
+const DefaultTag = "db"
+
+// Default Mapper of snake_case columns
+var Default = New(strcase.ToSnakeCase)
+
+// ErrMissingField Column has no destination field
+var ErrMissingField = errors.New("mapper: missing destination field")
+
+var scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+
+// Mapper Maps columns to struct fields, maps of struct types are cached
+type Mapper struct {
+	convert func(string) string
+	tag     string
+	cache   sync.Map // reflect.Type -> map[string][]int
+}
+
+// New Mapper with the converter of field names and the db tag overrides. Ex. New(strcase.ToSnakeCase)
+func New(convert func(string) string) *Mapper {
+	return NewWithTag(convert, DefaultTag)
+}
+
+// NewWithTag Mapper with the converter of field names and the tag overrides
+func NewWithTag(convert func(string) string, tag string) *Mapper {
+	return &Mapper{convert: convert, tag: tag}
+}
+
+// NameMapper Column name of the field name, compatible with sqlx MapperFunc. Ex. "CreatedAt" -> "created_at"
+func (m *Mapper) NameMapper(name string) string {
+	return m.convert(name)
+}
+
+// Columns Field index paths by column name of the struct type.
+// Embedded structs without tag are flattened, fields with tag "-" and unexported fields are skipped.
+func (m *Mapper) Columns(t reflect.Type) map[string][]int {
+	if cols, ok := m.cache.Load(t); ok {
+		return cols.(map[string][]int)
+	}
+	cols := map[string][]int{}
+	m.columns(t, nil, cols)
+	actual, _ := m.cache.LoadOrStore(t, cols)
+	return actual.(map[string][]int)
+}
+
+func (m *Mapper) columns(t reflect.Type, index []int, cols map[string][]int) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, hasTag := f.Tag.Lookup(m.tag)
+		name := strings.SplitN(tag, ",", 2)[0]
+		if name == "-" {
+			continue
+		}
+		fieldIndex := append(append(make([]int, 0, len(index)+1), index...), i)
+		if f.Anonymous && !hasTag && f.Type.Kind() == reflect.Struct && !reflect.PtrTo(f.Type).Implements(scannerType) {
+			m.columns(f.Type, fieldIndex, cols)
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = m.convert(f.Name)
+		}
+		if _, ok := cols[name]; !ok || len(fieldIndex) < len(cols[name]) {
+			cols[name] = fieldIndex
+		}
+	}
+}
+
+// ScanStruct Scans the current row into dest, a pointer to struct
+func (m *Mapper) ScanStruct(rows *sql.Rows, dest interface{}) error {
+	v := reflect.ValueOf(dest)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("mapper: dest must be a non-nil pointer to struct, got %T", dest)
+	}
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	targets, err := m.targets(cols, v.Elem())
+	if err != nil {
+		return err
+	}
+	return rows.Scan(targets...)
+}
+
+// ScanAll Scans all rows into dest, a pointer to slice of structs or pointers to structs
+func (m *Mapper) ScanAll(rows *sql.Rows, dest interface{}) error {
+	v := reflect.ValueOf(dest)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("mapper: dest must be a non-nil pointer to slice, got %T", dest)
+	}
+	slice := v.Elem()
+	elemType := slice.Type().Elem()
+	isPtr := elemType.Kind() == reflect.Ptr
+	structType := elemType
+	if isPtr {
+		structType = elemType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return fmt.Errorf("mapper: dest must be a pointer to slice of structs, got %T", dest)
+	}
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		elem := reflect.New(structType)
+		targets, err := m.targets(cols, elem.Elem())
+		if err != nil {
+			return err
+		}
+		if err := rows.Scan(targets...); err != nil {
+			return err
+		}
+		if isPtr {
+			slice = reflect.Append(slice, elem)
+		} else {
+			slice = reflect.Append(slice, elem.Elem())
+		}
+	}
+	v.Elem().Set(slice)
+	return rows.Err()
+}
+
+func (m *Mapper) targets(cols []string, v reflect.Value) ([]interface{}, error) {
+	fields := m.Columns(v.Type())
+	targets := make([]interface{}, len(cols))
+	for i, col := range cols {
+		index, ok := fields[col]
+		if !ok {
+			return nil, fmt.Errorf("%w for column %q in %s", ErrMissingField, col, v.Type())
+		}
+		targets[i] = v.FieldByIndex(index).Addr().Interface()
+	}
+	return targets, nil
+}
+
+// NameMapper Column name of the field name by Default mapper. Ex. "CreatedAt" -> "created_at"
+func NameMapper(name string) string {
+	return Default.NameMapper(name)
+}
+
+// ScanStruct Scans the current row into dest by Default mapper
+func ScanStruct(rows *sql.Rows, dest interface{}) error {
+	return Default.ScanStruct(rows, dest)
+}
+
+// ScanAll Scans all rows into dest by Default mapper
+func ScanAll(rows *sql.Rows, dest interface{}) error {
+	return Default.ScanAll(rows, dest)
+}
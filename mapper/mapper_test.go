@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mapper
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nikitaksv/strcase"
+)
+
+// fakeDriver Returns rows of the query "col1,col2|v1,v2|v1,v2", all values are strings
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt(query), nil }
+func (fakeConn) Close() error                              { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+
+type fakeStmt string
+
+func (fakeStmt) Close() error                               { return nil }
+func (fakeStmt) NumInput() int                              { return 0 }
+func (fakeStmt) Exec([]driver.Value) (driver.Result, error) { return nil, errors.New("not supported") }
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	lines := strings.Split(string(s), "|")
+	rows := &fakeRows{cols: strings.Split(lines[0], ",")}
+	for _, line := range lines[1:] {
+		rows.values = append(rows.values, strings.Split(line, ","))
+	}
+	return rows, nil
+}
+
+type fakeRows struct {
+	cols   []string
+	values [][]string
+}
+
+func (r *fakeRows) Columns() []string { return r.cols }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	for i, v := range r.values[0] {
+		dest[i] = v
+	}
+	r.values = r.values[1:]
+	return nil
+}
+
+func init() {
+	sql.Register("mapperfake", fakeDriver{})
+}
+
+type Base struct {
+	ID        int64
+	CreatedAt string
+}
+
+type User struct {
+	Base
+	UserName string
+	Email    string `db:"login"`
+	Password string `db:"-"`
+	Nick     sql.NullString
+	internal string
+}
+
+func query(t *testing.T, q string) *sql.Rows {
+	db, err := sql.Open("mapperfake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	rows, err := db.Query(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { rows.Close() })
+	return rows
+}
+
+func TestNameMapper(t *testing.T) {
+	if got := NameMapper("CreatedAt"); got != "created_at" {
+		t.Errorf("NameMapper() = %v, want created_at", got)
+	}
+	if got := New(strcase.ToCamelCase).NameMapper("CreatedAt"); got != "createdAt" {
+		t.Errorf("NameMapper() = %v, want createdAt", got)
+	}
+}
+
+func TestColumns(t *testing.T) {
+	got := Default.Columns(reflect.TypeOf(User{}))
+	want := map[string][]int{
+		"id":         {0, 0},
+		"created_at": {0, 1},
+		"user_name":  {1},
+		"login":      {2},
+		"nick":       {4},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Columns() = %v, want %v", got, want)
+	}
+	if got2 := Default.Columns(reflect.TypeOf(User{})); reflect.ValueOf(got2).Pointer() != reflect.ValueOf(got).Pointer() {
+		t.Errorf("Columns() is not cached")
+	}
+}
+
+func TestScanStruct(t *testing.T) {
+	rows := query(t, "id,user_name,login,created_at|1,bob,bob@example.com,2021")
+	if !rows.Next() {
+		t.Fatal("no rows")
+	}
+	var u User
+	if err := ScanStruct(rows, &u); err != nil {
+		t.Fatal(err)
+	}
+	want := User{Base: Base{ID: 1, CreatedAt: "2021"}, UserName: "bob", Email: "bob@example.com"}
+	if !reflect.DeepEqual(u, want) {
+		t.Errorf("ScanStruct() = %+v, want %+v", u, want)
+	}
+}
+
+func TestScanAll(t *testing.T) {
+	rows := query(t, "id,nick|1,bob|2,alice")
+	var users []*User
+	if err := ScanAll(rows, &users); err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 2 || users[0].ID != 1 || users[1].Nick.String != "alice" {
+		t.Errorf("ScanAll() = %+v", users)
+	}
+
+	type Event struct {
+		EventName string
+		StartsAt  time.Time `db:"-"`
+	}
+	rows = query(t, "event_name|start|stop")
+	var events []Event
+	if err := ScanAll(rows, &events); err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[1].EventName != "stop" {
+		t.Errorf("ScanAll() = %+v", events)
+	}
+}
+
+func TestScanErrors(t *testing.T) {
+	rows := query(t, "id,unknown|1,2")
+	var users []User
+	if err := ScanAll(rows, &users); !errors.Is(err, ErrMissingField) {
+		t.Errorf("ScanAll() error = %v, want %v", err, ErrMissingField)
+	}
+	if err := ScanAll(rows, users); err == nil {
+		t.Errorf("ScanAll() error = nil for non-pointer dest")
+	}
+	if err := ScanStruct(rows, &users); err == nil {
+		t.Errorf("ScanStruct() error = nil for slice dest")
+	}
+}
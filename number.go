@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	_numberOnes = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	_numberTens   = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	_numberScales = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+	_ordinalWords = map[string]string{
+		"one":    "first",
+		"two":    "second",
+		"three":  "third",
+		"five":   "fifth",
+		"eight":  "eighth",
+		"nine":   "ninth",
+		"twelve": "twelfth",
+	}
+)
+
+// Ordinal Ordinal suffix of number. Ex. 1 -> "st", 12 -> "th", 22 -> "nd"
+func Ordinal(n int) string {
+	abs := absNumber(n)
+	if abs%100 >= 11 && abs%100 <= 13 {
+		return "th"
+	}
+	switch abs % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+// Ordinalize Number with ordinal suffix. Ex. 1 -> "1st", 42 -> "42nd", -11 -> "-11th"
+func Ordinalize(n int) string {
+	return strconv.Itoa(n) + Ordinal(n)
+}
+
+// NumberToWords English words of number. Ex. 42 -> "forty-two", 1200 -> "one thousand two hundred"
+func NumberToWords(n int) string {
+	words := numberWords(absNumber(n))
+	if n < 0 {
+		words = "minus " + words
+	}
+	return words
+}
+
+// NumberToOrdinalWords English ordinal words of number. Ex. 1 -> "first", 42 -> "forty-second"
+func NumberToOrdinalWords(n int) string {
+	return ordinalWords(NumberToWords(n))
+}
+
+func absNumber(n int) uint64 {
+	if n < 0 {
+		return uint64(-(n + 1)) + 1
+	}
+	return uint64(n)
+}
+
+func numberWords(n uint64) string {
+	if n == 0 {
+		return _numberOnes[0]
+	}
+	var groups []string
+	for scale := 0; n > 0; scale++ {
+		if g := n % 1000; g > 0 {
+			words := hundredWords(g)
+			if _numberScales[scale] != "" {
+				words += " " + _numberScales[scale]
+			}
+			groups = append([]string{words}, groups...)
+		}
+		n /= 1000
+	}
+	return strings.Join(groups, " ")
+}
+
+// hundredWords English words of number from 1 to 999
+func hundredWords(n uint64) string {
+	var words []string
+	if n >= 100 {
+		words = append(words, _numberOnes[n/100], "hundred")
+		n %= 100
+	}
+	switch {
+	case n == 0:
+	case n < 20:
+		words = append(words, _numberOnes[n])
+	case n%10 == 0:
+		words = append(words, _numberTens[n/10])
+	default:
+		words = append(words, _numberTens[n/10]+"-"+_numberOnes[n%10])
+	}
+	return strings.Join(words, " ")
+}
+
+// ordinalWords Replaces last word of cardinal number words with ordinal word
+func ordinalWords(words string) string {
+	i := strings.LastIndexAny(words, " -") + 1
+	last := words[i:]
+	if ord, ok := _ordinalWords[last]; ok {
+		return words[:i] + ord
+	}
+	if strings.HasSuffix(last, "y") {
+		return words[:i] + strings.TrimSuffix(last, "y") + "ieth"
+	}
+	return words + "th"
+}
+
+// spellLeadingDigitsWords Spells out leading digits of the first word, see Converter.SetSpellLeadingDigits.
+// Numbers too large for int are spelled digit by digit
+func spellLeadingDigitsWords(words [][]rune) [][]rune {
+	if len(words) == 0 {
+		return words
+	}
+	first := words[0]
+	digits := 0
+	for digits < len(first) && first[digits] >= '0' && first[digits] <= '9' {
+		digits++
+	}
+	if digits == 0 {
+		return words
+	}
+	rest := first[digits:]
+	n, err := strconv.Atoi(string(first[:digits]))
+	if err != nil {
+		spelled := make([][]rune, 0, digits+len(words))
+		for _, d := range first[:digits] {
+			spelled = append(spelled, []rune(_numberOnes[d-'0']))
+		}
+		if len(rest) > 0 {
+			spelled = append(spelled, rest)
+		}
+		return append(spelled, words[1:]...)
+	}
+
+	spelled := NumberToWords(n)
+	if len(rest) >= 2 && string(rest[:2]) == Ordinal(n) && (len(rest) == 2 || !unicode.IsLetter(rest[2])) {
+		spelled = ordinalWords(spelled)
+		rest = rest[2:]
+	}
+
+	res := ParseRunes([]rune(spelled))
+	if len(rest) > 0 {
+		res = append(res, rest)
+	}
+	return append(res, words[1:]...)
+}
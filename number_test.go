@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestOrdinalize(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{n: 0, want: "0th"},
+		{n: 1, want: "1st"},
+		{n: 2, want: "2nd"},
+		{n: 3, want: "3rd"},
+		{n: 4, want: "4th"},
+		{n: 11, want: "11th"},
+		{n: 12, want: "12th"},
+		{n: 13, want: "13th"},
+		{n: 21, want: "21st"},
+		{n: 102, want: "102nd"},
+		{n: 1003, want: "1003rd"},
+		{n: -21, want: "-21st"},
+		{n: -1013, want: "-1013th"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := Ordinalize(tt.n); got != tt.want {
+				t.Errorf("Ordinalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumberToWords(t *testing.T) {
+	tests := []struct {
+		n       int
+		want    string
+		ordinal string
+	}{
+		{n: 0, want: "zero", ordinal: "zeroth"},
+		{n: 1, want: "one", ordinal: "first"},
+		{n: 2, want: "two", ordinal: "second"},
+		{n: 12, want: "twelve", ordinal: "twelfth"},
+		{n: 20, want: "twenty", ordinal: "twentieth"},
+		{n: 42, want: "forty-two", ordinal: "forty-second"},
+		{n: 100, want: "one hundred", ordinal: "one hundredth"},
+		{n: 1200, want: "one thousand two hundred", ordinal: "one thousand two hundredth"},
+		{n: 1000003, want: "one million three", ordinal: "one million third"},
+		{n: -5, want: "minus five", ordinal: "minus fifth"},
+		{n: math.MaxInt32, want: "two billion one hundred forty-seven million four hundred eighty-three thousand six hundred forty-seven", ordinal: "two billion one hundred forty-seven million four hundred eighty-three thousand six hundred forty-seventh"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := NumberToWords(tt.n); got != tt.want {
+				t.Errorf("NumberToWords() = %v, want %v", got, tt.want)
+			}
+			if got := NumberToOrdinalWords(tt.n); got != tt.ordinal {
+				t.Errorf("NumberToOrdinalWords() = %v, want %v", got, tt.ordinal)
+			}
+		})
+	}
+}
+
+func TestConverterSpellLeadingDigits(t *testing.T) {
+	c := NewConverter(nil)
+	c.SetSpellLeadingDigits(true)
+
+	tests := []struct {
+		name string
+		str  string
+		f    func(string) string
+		want string
+	}{
+		{name: "PascalCase", str: "3d model", f: c.ToPascalCase, want: "ThreeDModel"},
+		{name: "PascalCase number", str: "42", f: c.ToPascalCase, want: "FortyTwo"},
+		{name: "PascalCase ordinal", str: "1st", f: c.ToPascalCase, want: "First"},
+		{name: "snake_case ordinal", str: "2nd place", f: c.ToSnakeCase, want: "second_place"},
+		{name: "camelCase", str: "21st_century", f: c.ToCamelCase, want: "twentyFirstCentury"},
+		{name: "not ordinal suffix", str: "2nds", f: c.ToPascalCase, want: "TwoNds"},
+		{name: "inner digits", str: "model 3", f: c.ToPascalCase, want: "Model3"},
+		{name: "overflow", str: "99999999999999999999 items", f: c.ToSnakeCase, want: strings.Repeat("nine_", 20) + "items"},
+		{name: "overflow suffix", str: "123456789012345678901st", f: c.ToCamelCase, want: "oneTwoThreeFourFiveSixSevenEightNineZeroOneTwoThreeFourFiveSixSevenEightNineZeroOneSt"},
+		{name: "global unchanged", str: "2nd place", f: ToSnakeCase, want: "2nd_place"},
+		{name: "slug unchanged", str: "2nd place", f: ToSlug, want: "2nd-place"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f(tt.str); got != tt.want {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+// Protobuf names are ASCII, the functions work on bytes as protoc does.
+
+// ProtoJSONName json_name of the proto field as derived by protoc. Ex. "foo_bar_1" -> "fooBar1", "_foo" -> "Foo"
+//
+// Underscores are removed and the next letter is upper-cased, other letters are kept as is.
+func ProtoJSONName(name string) string {
+	b := make([]byte, 0, len(name))
+	upper := false
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if c == '_' {
+			upper = true
+			continue
+		}
+		if upper && isASCIILower(c) {
+			c -= 'a' - 'A'
+		}
+		upper = false
+		b = append(b, c)
+	}
+	return string(b)
+}
+
+// ProtoFieldName proto field name of the json_name, inverse of ProtoJSONName for lower snake_case fields. Ex. "fooBar" -> "foo_bar"
+func ProtoFieldName(jsonName string) string {
+	b := make([]byte, 0, len(jsonName)+2)
+	for i := 0; i < len(jsonName); i++ {
+		c := jsonName[i]
+		if isASCIIUpper(c) {
+			b = append(b, '_')
+			c += 'a' - 'A'
+		}
+		b = append(b, c)
+	}
+	return string(b)
+}
+
+// ProtoGoName Go name of the proto field or message as generated by protoc-gen-go. Ex. "foo_bar_1" -> "FooBar_1", "_foo" -> "XFoo"
+//
+// Words start at "_" or an upper letter and are capitalized, "_" before a lower letter is removed,
+// a leading "_" becomes "X" and "." of nested names becomes "_" unless followed by a lower letter.
+func ProtoGoName(name string) string {
+	b := make([]byte, 0, len(name)+1)
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '.' && i+1 < len(name) && isASCIILower(name[i+1]):
+		case c == '.':
+			b = append(b, '_')
+		case c == '_' && (i == 0 || name[i-1] == '.'):
+			b = append(b, 'X')
+		case c == '_' && i+1 < len(name) && isASCIILower(name[i+1]):
+		case isASCIIDigit(c):
+			b = append(b, c)
+		default:
+			if isASCIILower(c) {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+			for ; i+1 < len(name) && isASCIILower(name[i+1]); i++ {
+				b = append(b, name[i+1])
+			}
+		}
+	}
+	return string(b)
+}
+
+func isASCIILower(c byte) bool {
+	return 'a' <= c && c <= 'z'
+}
+
+func isASCIIUpper(c byte) bool {
+	return 'A' <= c && c <= 'Z'
+}
+
+func isASCIIDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// lowerSnake Names restored by ProtoFieldName
+var lowerSnake = regexp.MustCompile(`^[a-z]+(_[a-z]+)*$`)
+
+func TestProtoNames(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "protonames.tsv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		if s.Text() == "" || strings.HasPrefix(s.Text(), "#") {
+			continue
+		}
+		cols := strings.Split(s.Text(), "\t")
+		if len(cols) != 3 {
+			t.Fatalf("invalid line %q", s.Text())
+		}
+		for i, col := range cols {
+			if col == "-" {
+				cols[i] = ""
+			}
+		}
+		name, jsonName, goName := cols[0], cols[1], cols[2]
+		if got := ProtoJSONName(name); got != jsonName {
+			t.Errorf("ProtoJSONName(%q) = %q, want %q", name, got, jsonName)
+		}
+		if got := ProtoGoName(name); got != goName {
+			t.Errorf("ProtoGoName(%q) = %q, want %q", name, got, goName)
+		}
+		if lowerSnake.MatchString(name) {
+			if got := ProtoFieldName(jsonName); got != name {
+				t.Errorf("ProtoFieldName(%q) = %q, want %q", jsonName, got, name)
+			}
+		}
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProtoGoNameNested(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "one.two", want: "OneTwo"},
+		{name: "one.Two", want: "One_Two"},
+		{name: "one_two.three_four", want: "OneTwoThreeFour"},
+		{name: "one_two.Three_four", want: "OneTwo_ThreeFour"},
+		{name: "_one._two", want: "XOne_XTwo"},
+	}
+	for _, tt := range tests {
+		if got := ProtoGoName(tt.name); got != tt.want {
+			t.Errorf("ProtoGoName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestProtoFieldName(t *testing.T) {
+	tests := []struct {
+		jsonName string
+		want     string
+	}{
+		{jsonName: "fooBar", want: "foo_bar"},
+		{jsonName: "fooBarBaz", want: "foo_bar_baz"},
+		{jsonName: "userId", want: "user_id"},
+		{jsonName: "int32Value", want: "int32_value"},
+		{jsonName: "aBC", want: "a_b_c"},
+	}
+	for _, tt := range tests {
+		if got := ProtoFieldName(tt.jsonName); got != tt.want {
+			t.Errorf("ProtoFieldName(%q) = %q, want %q", tt.jsonName, got, tt.want)
+		}
+	}
+}
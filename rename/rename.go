@@ -0,0 +1,713 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package rename renames identifiers of a Go package with a strcase converter.
+//
+// The package is parsed with go/parser and type-checked with go/types, so every reference
+// of a renamed object inside the package is updated. References from other packages are not updated.
+package rename
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Kind Kinds of identifiers selected for renaming
+type Kind uint
+
+const (
+	// Fields Struct fields, embedded fields are renamed with their type
+	Fields Kind = 1 << iota
+	// Methods Methods of types and interfaces
+	Methods
+	// Consts Constants
+	Consts
+	// Vars Package-level variables
+	Vars
+	// Funcs Package-level functions except main and init
+	Funcs
+	// Types Type names
+	Types
+	// Locals Local variables, parameters and results
+	Locals
+)
+
+var kindNames = []struct {
+	kind Kind
+	name string
+}{
+	{Fields, "fields"},
+	{Methods, "methods"},
+	{Consts, "consts"},
+	{Vars, "vars"},
+	{Funcs, "funcs"},
+	{Types, "types"},
+	{Locals, "locals"},
+}
+
+// ParseKind Parses comma separated kind names. Ex. "fields,methods"
+func ParseKind(str string) (Kind, error) {
+	var kind Kind
+	for _, name := range strings.Split(str, ",") {
+		name = strings.TrimSpace(name)
+		found := false
+		for _, k := range kindNames {
+			if k.name == name {
+				kind |= k.kind
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("rename: unknown kind %q", name)
+		}
+	}
+	return kind, nil
+}
+
+func (k Kind) String() string {
+	var names []string
+	for _, kn := range kindNames {
+		if k&kn.kind != 0 {
+			names = append(names, kn.name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// ErrCollisions Write is refused because the result has collisions
+var ErrCollisions = errors.New("rename: name collisions")
+
+// Config Rename configuration
+type Config struct {
+	// Kinds Identifiers to rename
+	Kinds Kind
+	// Convert strcase converter. Ex. strcase.ToCamelCaseAcronym
+	Convert func(string) string
+	// KeepExported Keeps the exported state of identifiers by the case of the first letter
+	KeepExported bool
+	// Tests Renames declarations of _test.go files and includes external test packages.
+	// References in test files of the package are always updated
+	Tests bool
+}
+
+// Rename Renamed declaration
+type Rename struct {
+	Pos  token.Position
+	Kind Kind
+	Old  string
+	New  string
+}
+
+// Collision Several objects of one scope have the same name after renaming, or the new name is not valid.
+// Objects with invalid new names are not renamed.
+type Collision struct {
+	Pos     token.Position
+	Name    string
+	Message string
+}
+
+func (c Collision) String() string {
+	return fmt.Sprintf("%s: %s: %s", c.Pos, c.Name, c.Message)
+}
+
+// Result Result of renaming
+type Result struct {
+	Renames    []Rename
+	Collisions []Collision
+	// TypeErrors Type errors of the renamed packages, Write is refused
+	TypeErrors TypeErrors
+	// Files New content of changed files by file name
+	Files    map[string][]byte
+	original map[string][]byte
+}
+
+// Dir Renames identifiers of the packages in dir
+func Dir(dir string, cfg Config) (*Result, error) {
+	if cfg.Convert == nil {
+		return nil, errors.New("rename: Config.Convert is nil")
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Result{Files: map[string][]byte{}, original: map[string][]byte{}}
+	names := make([]string, 0, len(pkgs))
+	for name, pkg := range pkgs {
+		if cfg.Tests || !isTestPackage(pkg) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := res.renamePackage(fset, dir, pkgs[name], cfg); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// isTestPackage Reports whether all files of the package are _test.go files, ex. an external test package
+func isTestPackage(pkg *ast.Package) bool {
+	for name := range pkg.Files {
+		if !isTestFile(name) {
+			return false
+		}
+	}
+	return true
+}
+
+func isTestFile(name string) bool {
+	return strings.HasSuffix(name, "_test.go")
+}
+
+// TypeErrors Type errors of a package, the package is not renamed
+type TypeErrors []error
+
+func (e TypeErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return "rename: type errors:\n" + strings.Join(msgs, "\n")
+}
+
+func (res *Result) renamePackage(fset *token.FileSet, dir string, astPkg *ast.Package, cfg Config) error {
+	fileNames := make([]string, 0, len(astPkg.Files))
+	for name := range astPkg.Files {
+		fileNames = append(fileNames, name)
+	}
+	sort.Strings(fileNames)
+	files := make([]*ast.File, 0, len(fileNames))
+	for _, name := range fileNames {
+		files = append(files, astPkg.Files[name])
+	}
+
+	info := &types.Info{
+		Defs:  map[*ast.Ident]types.Object{},
+		Uses:  map[*ast.Ident]types.Object{},
+		Types: map[ast.Expr]types.TypeAndValue{},
+	}
+	var typeErrs TypeErrors
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(err error) { typeErrs = append(typeErrs, err) },
+	}
+	pkg, _ := conf.Check(dir, fset, files, info)
+	if len(typeErrs) > 0 {
+		return typeErrs
+	}
+
+	newNames := map[types.Object]string{}
+	for ident, obj := range info.Defs {
+		if obj == nil || obj.Pkg() != pkg || ident.Name == "_" {
+			continue
+		}
+		kind := objectKind(obj)
+		if kind&cfg.Kinds == 0 || !cfg.Tests && isTestFile(fset.Position(obj.Pos()).Filename) {
+			continue
+		}
+		name := cfg.Convert(obj.Name())
+		if cfg.KeepExported {
+			name = keepExported(obj.Name(), name)
+		}
+		if name == obj.Name() {
+			continue
+		}
+		if !token.IsIdentifier(name) {
+			res.Collisions = append(res.Collisions, Collision{
+				Pos:     fset.Position(obj.Pos()),
+				Name:    name,
+				Message: fmt.Sprintf("%s is not a valid identifier", obj.Name()),
+			})
+			continue
+		}
+		newNames[obj] = name
+		res.Renames = append(res.Renames, Rename{Pos: fset.Position(obj.Pos()), Kind: kind, Old: obj.Name(), New: name})
+	}
+	// Embedded fields are named by their type
+	for _, obj := range info.Defs {
+		field, ok := obj.(*types.Var)
+		if !ok || !field.Embedded() || field.Pkg() != pkg {
+			continue
+		}
+		tn := embeddedTypeName(field)
+		if name, ok := newNames[tn]; ok {
+			newNames[field] = name
+			res.Renames = append(res.Renames, Rename{Pos: fset.Position(field.Pos()), Kind: Fields, Old: field.Name(), New: name})
+		}
+	}
+	sort.Slice(res.Renames, func(i, j int) bool {
+		return positionLess(res.Renames[i].Pos, res.Renames[j].Pos)
+	})
+
+	res.Collisions = append(res.Collisions, collisions(fset, pkg, files, info, newNames)...)
+	res.Collisions = append(res.Collisions, brokenImplementations(fset, pkg, info, newNames)...)
+	sort.Slice(res.Collisions, func(i, j int) bool {
+		return positionLess(res.Collisions[i].Pos, res.Collisions[j].Pos)
+	})
+
+	edits := map[string][]edit{}
+	addEdits := func(idents map[*ast.Ident]types.Object) {
+		for ident, obj := range idents {
+			if name, ok := newNames[obj]; ok {
+				pos := fset.Position(ident.Pos())
+				edits[pos.Filename] = append(edits[pos.Filename], edit{offset: pos.Offset, old: ident.Name, new: name})
+			}
+		}
+	}
+	addEdits(info.Defs)
+	addEdits(info.Uses)
+
+	for name, fileEdits := range edits {
+		src, err := ioutil.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		out, err := applyEdits(src, fileEdits)
+		if err != nil {
+			return fmt.Errorf("rename: %s: %v", name, err)
+		}
+		res.original[name] = src
+		res.Files[name] = out
+	}
+	if len(edits) == 0 {
+		return nil
+	}
+
+	// The renamed package must still compile, ex. renames missed by the collision checks
+	renamed := make([]*ast.File, 0, len(fileNames))
+	for _, name := range fileNames {
+		src, ok := res.Files[name]
+		if !ok {
+			renamed = append(renamed, astPkg.Files[name])
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, src, parser.ParseComments)
+		if err != nil {
+			res.TypeErrors = append(res.TypeErrors, err)
+			return nil
+		}
+		renamed = append(renamed, f)
+	}
+	conf.Error = func(err error) { res.TypeErrors = append(res.TypeErrors, err) }
+	_, _ = conf.Check(dir, fset, renamed, nil)
+	return nil
+}
+
+// embeddedTypeName Type name of the embedded field, nil if the field is named differently, ex. by an alias
+func embeddedTypeName(field *types.Var) *types.TypeName {
+	t := field.Type()
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Name() != field.Name() {
+		return nil
+	}
+	return named.Obj()
+}
+
+// Diff Writes unified diff of changed files
+func (res *Result) Diff() string {
+	names := make([]string, 0, len(res.Files))
+	for name := range res.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(unifiedDiff(name, string(res.original[name]), string(res.Files[name])))
+	}
+	return b.String()
+}
+
+// Write Writes changed files, returns ErrCollisions if the result has collisions
+// and TypeErrors if the renamed packages do not compile
+func (res *Result) Write() error {
+	if len(res.Collisions) > 0 {
+		return ErrCollisions
+	}
+	if len(res.TypeErrors) > 0 {
+		return res.TypeErrors
+	}
+	for name, src := range res.Files {
+		fi, err := os.Stat(name)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(name, src, fi.Mode()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func objectKind(obj types.Object) Kind {
+	switch obj := obj.(type) {
+	case *types.Var:
+		switch {
+		case obj.Embedded():
+			return 0
+		case obj.IsField():
+			return Fields
+		case obj.Parent() == obj.Pkg().Scope():
+			return Vars
+		}
+		return Locals
+	case *types.Const:
+		return Consts
+	case *types.TypeName:
+		return Types
+	case *types.Func:
+		if obj.Type().(*types.Signature).Recv() != nil {
+			return Methods
+		}
+		if obj.Name() == "main" || obj.Name() == "init" {
+			return 0
+		}
+		return Funcs
+	}
+	return 0
+}
+
+func keepExported(old, name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	if r == utf8.RuneError {
+		return name
+	}
+	if ast.IsExported(old) {
+		return string(unicode.ToUpper(r)) + name[size:]
+	}
+	return string(unicode.ToLower(r)) + name[size:]
+}
+
+// collisions Finds names declared more than once in a scope, a struct or a method set after renaming,
+// and references resolving to another object after renaming
+func collisions(fset *token.FileSet, pkg *types.Package, files []*ast.File, info *types.Info, newNames map[types.Object]string) []Collision {
+	res := shadowed(fset, pkg, info, newNames)
+
+	var namespaces [][]types.Object
+
+	var walk func(scope *types.Scope)
+	walk = func(scope *types.Scope) {
+		objs := make([]types.Object, 0, scope.Len())
+		for _, name := range scope.Names() {
+			objs = append(objs, scope.Lookup(name))
+		}
+		namespaces = append(namespaces, objs)
+		for i := 0; i < scope.NumChildren(); i++ {
+			walk(scope.Child(i))
+		}
+	}
+	walk(pkg.Scope())
+
+	seen := map[types.Type]bool{}
+	for _, obj := range info.Defs {
+		tn, ok := obj.(*types.TypeName)
+		if !ok || tn.Pkg() != pkg {
+			continue
+		}
+		named, ok := tn.Type().(*types.Named)
+		if !ok || seen[named] {
+			continue
+		}
+		seen[named] = true
+		var objs []types.Object
+		for i := 0; i < named.NumMethods(); i++ {
+			objs = append(objs, named.Method(i))
+		}
+		if st, ok := named.Underlying().(*types.Struct); ok {
+			seen[st] = true
+			for i := 0; i < st.NumFields(); i++ {
+				objs = append(objs, st.Field(i))
+			}
+		}
+		if it, ok := named.Underlying().(*types.Interface); ok {
+			seen[it] = true
+			for i := 0; i < it.NumMethods(); i++ {
+				objs = append(objs, it.Method(i))
+			}
+		}
+		namespaces = append(namespaces, objs)
+	}
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			expr, ok := n.(*ast.StructType)
+			if !ok {
+				return true
+			}
+			st, ok := info.TypeOf(expr).(*types.Struct)
+			if !ok || seen[st] {
+				return true
+			}
+			seen[st] = true
+			objs := make([]types.Object, 0, st.NumFields())
+			for i := 0; i < st.NumFields(); i++ {
+				objs = append(objs, st.Field(i))
+			}
+			namespaces = append(namespaces, objs)
+			return true
+		})
+	}
+
+	for _, objs := range namespaces {
+		byName := map[string][]types.Object{}
+		for _, obj := range objs {
+			name := obj.Name()
+			if n, ok := newNames[obj]; ok {
+				name = n
+			}
+			if name != "_" {
+				byName[name] = append(byName[name], obj)
+			}
+		}
+		for name, objs := range byName {
+			if len(objs) < 2 {
+				continue
+			}
+			var renamed, olds []string
+			for _, obj := range objs {
+				olds = append(olds, obj.Name())
+				if _, ok := newNames[obj]; ok {
+					renamed = append(renamed, obj.Name())
+				}
+			}
+			if len(renamed) == 0 {
+				continue
+			}
+			sort.Strings(olds)
+			res = append(res, Collision{
+				Pos:     fset.Position(objs[0].Pos()),
+				Name:    name,
+				Message: fmt.Sprintf("declared by %s after renaming", strings.Join(olds, ", ")),
+			})
+		}
+	}
+	return res
+}
+
+// shadowed Finds references that resolve to another object when looked up by the new names from the scope of the reference.
+// Ex. a local foo_bar renamed to fooBar shadows the package-level fooBar in nested scopes.
+func shadowed(fset *token.FileSet, pkg *types.Package, info *types.Info, newNames map[types.Object]string) []Collision {
+	if len(newNames) == 0 {
+		return nil
+	}
+	nameOf := func(obj types.Object) string {
+		if name, ok := newNames[obj]; ok {
+			return name
+		}
+		return obj.Name()
+	}
+	scopeNames := map[*types.Scope]map[string][]types.Object{}
+	lookup := func(scope *types.Scope, name string, pos token.Pos) types.Object {
+		for s := scope; s != nil; s = s.Parent() {
+			names, ok := scopeNames[s]
+			if !ok {
+				names = map[string][]types.Object{}
+				for _, n := range s.Names() {
+					obj := s.Lookup(n)
+					names[nameOf(obj)] = append(names[nameOf(obj)], obj)
+				}
+				scopeNames[s] = names
+			}
+			// Objects of function scopes are visible after their declaration only
+			local := s != types.Universe && s != pkg.Scope() && s.Parent() != pkg.Scope()
+			for _, obj := range names[name] {
+				if !local || obj.Pos() <= pos {
+					return obj
+				}
+			}
+		}
+		return nil
+	}
+
+	type pair struct{ obj, found types.Object }
+	seen := map[pair]bool{}
+	var res []Collision
+	for ident, obj := range info.Uses {
+		// Fields, methods and objects of other packages are selected, not looked up
+		if obj.Parent() == nil || obj.Pkg() != nil && obj.Pkg() != pkg {
+			continue
+		}
+		scope := pkg.Scope().Innermost(ident.Pos())
+		if scope == nil {
+			continue
+		}
+		name := nameOf(obj)
+		found := lookup(scope, name, ident.Pos())
+		if found == obj || found == nil || seen[pair{obj, found}] {
+			continue
+		}
+		seen[pair{obj, found}] = true
+		res = append(res, Collision{
+			Pos:     fset.Position(ident.Pos()),
+			Name:    name,
+			Message: fmt.Sprintf("reference to %s resolves to %s declared at %s after renaming", obj.Name(), found.Name(), fset.Position(found.Pos())),
+		})
+	}
+	return res
+}
+
+// brokenImplementations Finds renamed methods that implement an interface used by the package
+// under another name after renaming. Ex. ServeHTTP renamed to ServeHttp no longer implements http.Handler.
+// Interfaces and named types are collected from the types of expressions and declarations of the package.
+func brokenImplementations(fset *token.FileSet, pkg *types.Package, info *types.Info, newNames map[types.Object]string) []Collision {
+	if len(newNames) == 0 {
+		return nil
+	}
+	nameOf := func(obj types.Object) string {
+		if name, ok := newNames[obj]; ok {
+			return name
+		}
+		return obj.Name()
+	}
+
+	var ifaces, concrete []types.Type
+	seen := map[types.Type]bool{}
+	var collect func(t types.Type)
+	collect = func(t types.Type) {
+		if t == nil || seen[t] {
+			return
+		}
+		seen[t] = true
+		switch t := t.(type) {
+		case *types.Named:
+			if types.IsInterface(t) {
+				ifaces = append(ifaces, t)
+			} else {
+				concrete = append(concrete, t, types.NewPointer(t))
+			}
+			// Types of other packages are not walked further than their name
+			if t.Obj().Pkg() == pkg {
+				collect(t.Underlying())
+			}
+		case *types.Interface:
+			ifaces = append(ifaces, t)
+		case *types.Pointer:
+			collect(t.Elem())
+		case *types.Slice:
+			collect(t.Elem())
+		case *types.Array:
+			collect(t.Elem())
+		case *types.Map:
+			collect(t.Key())
+			collect(t.Elem())
+		case *types.Chan:
+			collect(t.Elem())
+		case *types.Signature:
+			for _, tuple := range []*types.Tuple{t.Params(), t.Results()} {
+				for i := 0; i < tuple.Len(); i++ {
+					collect(tuple.At(i).Type())
+				}
+			}
+		case *types.Struct:
+			for i := 0; i < t.NumFields(); i++ {
+				collect(t.Field(i).Type())
+			}
+		}
+	}
+	for _, tv := range info.Types {
+		collect(tv.Type)
+	}
+	for _, obj := range info.Defs {
+		if obj != nil {
+			collect(obj.Type())
+		}
+	}
+
+	type pair struct{ method, ifaceMethod types.Object }
+	reported := map[pair]bool{}
+	var res []Collision
+	for _, iface := range ifaces {
+		it := iface.Underlying().(*types.Interface)
+		if it.NumMethods() == 0 {
+			continue
+		}
+		for _, t := range concrete {
+			if !types.Implements(t, it) {
+				continue
+			}
+			for i := 0; i < it.NumMethods(); i++ {
+				m := it.Method(i)
+				obj, _, _ := types.LookupFieldOrMethod(t, false, m.Pkg(), m.Name())
+				method, ok := obj.(*types.Func)
+				if !ok || nameOf(method) == nameOf(m) || reported[pair{method, m}] {
+					continue
+				}
+				reported[pair{method, m}] = true
+				renamed := types.Object(method)
+				if _, ok := newNames[method]; !ok {
+					renamed = m
+				}
+				res = append(res, Collision{
+					Pos:  fset.Position(renamed.Pos()),
+					Name: nameOf(renamed),
+					Message: fmt.Sprintf("renaming %s breaks the implementation of %s by %s",
+						renamed.Name(), types.TypeString(iface, types.RelativeTo(pkg)), types.TypeString(t, types.RelativeTo(pkg))),
+				})
+			}
+		}
+	}
+	return res
+}
+
+type edit struct {
+	offset   int
+	old, new string
+}
+
+func applyEdits(src []byte, edits []edit) ([]byte, error) {
+	sort.Slice(edits, func(i, j int) bool { return edits[i].offset < edits[j].offset })
+	out := make([]byte, 0, len(src))
+	last := 0
+	for _, e := range edits {
+		if e.offset < last {
+			continue
+		}
+		if string(src[e.offset:e.offset+len(e.old)]) != e.old {
+			return nil, fmt.Errorf("identifier %s not found at offset %d", e.old, e.offset)
+		}
+		out = append(out, src[last:e.offset]...)
+		out = append(out, e.new...)
+		last = e.offset + len(e.old)
+	}
+	out = append(out, src[last:]...)
+	return format.Source(out)
+}
+
+func positionLess(a, b token.Position) bool {
+	if a.Filename != b.Filename {
+		return a.Filename < b.Filename
+	}
+	return a.Offset < b.Offset
+}
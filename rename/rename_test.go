@@ -0,0 +1,245 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rename
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nikitaksv/strcase"
+)
+
+func TestDir(t *testing.T) {
+	res, err := Dir(filepath.Join("testdata", "shop"), Config{
+		Kinds:        Fields | Methods | Consts | Funcs | Locals,
+		Convert:      strcase.ToCamelCaseAcronym,
+		KeepExported: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Collisions) > 0 {
+		t.Fatalf("Dir() collisions = %v", res.Collisions)
+	}
+
+	var renames []string
+	for _, r := range res.Renames {
+		renames = append(renames, r.Kind.String()+":"+r.Old+"->"+r.New)
+	}
+	wantRenames := []string{
+		"consts:default_currency->defaultCurrency",
+		"fields:order_id->orderID",
+		"fields:Item_Name->ItemName",
+		"methods:format_name->formatName",
+		"locals:item_name->itemName",
+		"funcs:New_order->NewOrder",
+		"locals:order_id->orderID",
+		"locals:item_name->itemName",
+	}
+	if !reflect.DeepEqual(renames, wantRenames) {
+		t.Errorf("Dir() renames = %v, want %v", renames, wantRenames)
+	}
+
+	want := `package shop
+
+import "strings"
+
+const defaultCurrency = "USD"
+
+type Order struct {
+	orderID    int
+	ItemName   string
+	TotalPrice float64
+}
+
+func (o *Order) formatName() string {
+	itemName := strings.ToUpper(o.ItemName)
+	return itemName + " " + defaultCurrency
+}
+
+func NewOrder(orderID int, itemName string) *Order {
+	return &Order{orderID: orderID, ItemName: itemName}
+}
+`
+	name := filepath.Join("testdata", "shop", "shop.go")
+	if got := string(res.Files[name]); got != want {
+		t.Errorf("Dir() file =\n%s\nwant\n%s", got, want)
+	}
+
+	testName := filepath.Join("testdata", "shop", "shop_test.go")
+	if got := string(res.Files[testName]); !strings.Contains(got, "if o := NewOrder(want_id, \"pen\"); o.orderID != want_id {") {
+		t.Errorf("Dir() test file references are not updated:\n%s", got)
+	}
+
+	diff := res.Diff()
+	for _, line := range []string{
+		"--- " + name,
+		"+++ " + name,
+		"-const default_currency = \"USD\"",
+		"+const defaultCurrency = \"USD\"",
+		"-func New_order(order_id int, item_name string) *Order {",
+		"+func NewOrder(orderID int, itemName string) *Order {",
+		" import \"strings\"",
+	} {
+		if !strings.Contains(diff, line+"\n") {
+			t.Errorf("Diff() does not contain %q:\n%s", line, diff)
+		}
+	}
+}
+
+func TestDirTests(t *testing.T) {
+	res, err := Dir(filepath.Join("testdata", "shop"), Config{
+		Kinds:   Locals,
+		Convert: strcase.ToCamelCase,
+		Tests:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	name := filepath.Join("testdata", "shop", "shop_test.go")
+	if got := string(res.Files[name]); !strings.Contains(got, "wantId := 1") {
+		t.Errorf("Dir() test file declarations are not renamed:\n%s", got)
+	}
+}
+
+func TestDirTypeErrors(t *testing.T) {
+	_, err := Dir(filepath.Join("testdata", "typeerror"), Config{Kinds: Fields, Convert: strcase.ToCamelCase})
+	typeErrs, ok := err.(TypeErrors)
+	if !ok || len(typeErrs) != 1 || !strings.Contains(typeErrs[0].Error(), "user_nam") {
+		t.Errorf("Dir() error = %v, want type error of user_nam", err)
+	}
+}
+
+func TestDirCollisions(t *testing.T) {
+	res, err := Dir(filepath.Join("testdata", "collision"), Config{
+		Kinds:   Fields,
+		Convert: strcase.ToCamelCase,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, c := range res.Collisions {
+		got = append(got, c.Name+": "+c.Message)
+	}
+	want := []string{
+		"userName: declared by userName, user_name after renaming",
+		"type: type_ is not a valid identifier",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Dir() collisions = %v, want %v", got, want)
+	}
+	if err := res.Write(); err != ErrCollisions {
+		t.Errorf("Write() error = %v, want %v", err, ErrCollisions)
+	}
+}
+
+func TestDirShadowing(t *testing.T) {
+	res, err := Dir(filepath.Join("testdata", "shadow"), Config{
+		Kinds:   Locals,
+		Convert: strcase.ToCamelCase,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, c := range res.Collisions {
+		got = append(got, c.Pos.String()+": "+c.Name+": "+c.Message)
+	}
+	name := filepath.Join("testdata", "shadow", "shadow.go")
+	want := []string{
+		name + ":8:10: fooBar: reference to fooBar resolves to foo_bar declared at " + name + ":6:2 after renaming",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Dir() collisions = %v, want %v", got, want)
+	}
+}
+
+func TestDirEmbedded(t *testing.T) {
+	res, err := Dir(filepath.Join("testdata", "embed"), Config{Kinds: Types, Convert: strcase.ToCamelCase, KeepExported: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Collisions) > 0 || len(res.TypeErrors) > 0 {
+		t.Fatalf("Dir() collisions = %v, type errors = %v", res.Collisions, res.TypeErrors)
+	}
+	got := string(res.Files[filepath.Join("testdata", "embed", "embed.go")])
+	for _, line := range []string{
+		"\tbaseInfo\n",
+		"\t*baseInfo\n",
+		"func Get(o Outer) baseInfo {\n\treturn o.baseInfo\n",
+		"return PtrOuter{baseInfo: &baseInfo{id: id}}",
+	} {
+		if !strings.Contains(got, line) {
+			t.Errorf("Dir() file does not contain %q:\n%s", line, got)
+		}
+	}
+}
+
+func TestDirInterfaces(t *testing.T) {
+	res, err := Dir(filepath.Join("testdata", "iface"), Config{Kinds: Methods, Convert: strcase.ToPascalCase})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, c := range res.Collisions {
+		got = append(got, c.Name+": "+c.Message)
+	}
+	want := []string{
+		"ServeHttp: renaming ServeHTTP breaks the implementation of net/http.Handler by H",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Dir() collisions = %v, want %v", got, want)
+	}
+	if len(res.TypeErrors) != 1 {
+		t.Errorf("Dir() type errors = %v, want one", res.TypeErrors)
+	}
+	if err := res.Write(); err != ErrCollisions {
+		t.Errorf("Write() error = %v, want %v", err, ErrCollisions)
+	}
+}
+
+func TestDirRenamedTypeErrors(t *testing.T) {
+	res, err := Dir(filepath.Join("testdata", "promoted"), Config{Kinds: Methods, Convert: strcase.ToPascalCase})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Collisions) > 0 {
+		t.Fatalf("Dir() collisions = %v", res.Collisions)
+	}
+	if len(res.TypeErrors) == 0 || !strings.Contains(res.TypeErrors[0].Error(), "u.Name") {
+		t.Errorf("Dir() type errors = %v, want error of u.Name", res.TypeErrors)
+	}
+	if err := res.Write(); !reflect.DeepEqual(err, res.TypeErrors) {
+		t.Errorf("Write() error = %v, want %v", err, res.TypeErrors)
+	}
+}
+
+func TestParseKind(t *testing.T) {
+	kind, err := ParseKind("fields, methods")
+	if err != nil || kind != Fields|Methods {
+		t.Errorf("ParseKind() = %v, %v, want fields,methods", kind, err)
+	}
+	if _, err := ParseKind("fields,labels"); err == nil {
+		t.Errorf("ParseKind() error = nil, want error")
+	}
+}
@@ -0,0 +1,7 @@
+package collision
+
+type User struct {
+	user_name string
+	userName  string
+	type_     int
+}
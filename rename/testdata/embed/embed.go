@@ -0,0 +1,21 @@
+package embed
+
+type base_info struct {
+	id int
+}
+
+type Outer struct {
+	base_info
+}
+
+type PtrOuter struct {
+	*base_info
+}
+
+func Get(o Outer) base_info {
+	return o.base_info
+}
+
+func New(id int) PtrOuter {
+	return PtrOuter{base_info: &base_info{id: id}}
+}
@@ -0,0 +1,26 @@
+package iface
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type H struct{}
+
+func (H) ServeHTTP(w http.ResponseWriter, r *http.Request) {}
+
+func (H) Format_Name() string { return "h" }
+
+var _ http.Handler = H{}
+
+type Named interface {
+	Format_Name() string
+}
+
+type Code int
+
+func (c Code) String() string { return fmt.Sprint(int(c)) }
+
+func Print(n Named, c Code) {
+	fmt.Println(n.Format_Name(), c)
+}
@@ -0,0 +1,13 @@
+package promoted
+
+type Base struct {
+	Name string
+}
+
+type User struct {
+	Base
+}
+
+func (u User) name_() string {
+	return u.Name
+}
@@ -0,0 +1,11 @@
+package shadow
+
+var fooBar = 1
+
+func Value() int {
+	foo_bar := 2
+	if foo_bar > 0 {
+		return fooBar
+	}
+	return foo_bar
+}
@@ -0,0 +1,20 @@
+package shop
+
+import "strings"
+
+const default_currency = "USD"
+
+type Order struct {
+	order_id   int
+	Item_Name  string
+	TotalPrice float64
+}
+
+func (o *Order) format_name() string {
+	item_name := strings.ToUpper(o.Item_Name)
+	return item_name + " " + default_currency
+}
+
+func New_order(order_id int, item_name string) *Order {
+	return &Order{order_id: order_id, Item_Name: item_name}
+}
@@ -0,0 +1,10 @@
+package shop
+
+import "testing"
+
+func TestNewOrder(t *testing.T) {
+	want_id := 1
+	if o := New_order(want_id, "pen"); o.order_id != want_id {
+		t.Errorf("New_order() = %v", o)
+	}
+}
@@ -0,0 +1,9 @@
+package typeerror
+
+type User struct {
+	user_name string
+}
+
+func Name(u User) string {
+	return u.user_nam
+}
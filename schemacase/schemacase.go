@@ -0,0 +1,582 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package schemacase renames properties of JSON Schema and OpenAPI documents with a strcase converter.
+//
+// Property names are renamed in "properties", "required", "discriminator" property names,
+// "/properties/" segments of "$ref" and discriminator mapping targets, and in the instances
+// of "example", "examples" and "default" by their schema. Key order of the document is kept.
+package schemacase
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Config Rename configuration
+type Config struct {
+	// Convert strcase converter. Ex. strcase.ToCamelCase
+	Convert func(string) string
+	// Indent Indent of the output, compact output if empty
+	Indent string
+}
+
+// ErrDuplicateKey Several keys of an instance, ex. an example, are renamed to the same name
+var ErrDuplicateKey = errors.New("schemacase: duplicate key")
+
+// Collision Several properties of one schema have the same name after renaming, they are not renamed
+type Collision struct {
+	// Pointer JSON pointer of the properties object. Ex. /components/schemas/User/properties
+	Pointer string
+	Name    string
+	Keys    []string
+}
+
+func (c Collision) String() string {
+	return fmt.Sprintf("%s: %s collide as %q", c.Pointer, strings.Join(c.Keys, ", "), c.Name)
+}
+
+// Result Result of renaming
+type Result struct {
+	// JSON Renamed document
+	JSON       []byte
+	Collisions []Collision
+}
+
+// Rename Renames properties of the JSON Schema or OpenAPI document
+func Rename(src []byte, cfg Config) (*Result, error) {
+	if cfg.Convert == nil {
+		return nil, errors.New("schemacase: Config.Convert is nil")
+	}
+	root, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+	r := &renamer{root: root, convert: cfg.Convert, renames: map[*node]map[string]string{}}
+	r.schemaProperties(root, "")
+	sort.Slice(r.collisions, func(i, j int) bool {
+		if r.collisions[i].Pointer != r.collisions[j].Pointer {
+			return r.collisions[i].Pointer < r.collisions[j].Pointer
+		}
+		return r.collisions[i].Name < r.collisions[j].Name
+	})
+	// Instances are renamed first, they are matched to schemas by the original names
+	r.walk(root, true)
+	if r.err != nil {
+		return nil, r.err
+	}
+	r.walk(root, false)
+
+	var buf bytes.Buffer
+	if err := root.encode(&buf); err != nil {
+		return nil, err
+	}
+	res := &Result{JSON: buf.Bytes(), Collisions: r.collisions}
+	if cfg.Indent != "" {
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, buf.Bytes(), "", cfg.Indent); err != nil {
+			return nil, err
+		}
+		indented.WriteByte('\n')
+		res.JSON = indented.Bytes()
+	}
+	return res, nil
+}
+
+type renamer struct {
+	root       *node
+	convert    func(string) string
+	collisions []Collision
+	// renames New names of properties by schema, collected before renaming
+	renames map[*node]map[string]string
+	// err First ErrDuplicateKey of instances
+	err error
+}
+
+// schemaProperties Collects new names of every "properties" object of the document.
+// Examples are instances and have no schemas
+func (r *renamer) schemaProperties(n *node, pointer string) {
+	switch n.kind {
+	case '[':
+		for i, item := range n.items {
+			r.schemaProperties(item, pointer+"/"+strconv.Itoa(i))
+		}
+	case '{':
+		for _, m := range n.members {
+			p := pointer + "/" + escapePointer(m.key)
+			switch m.key {
+			case "example", "examples", "default", "const", "enum":
+				continue
+			case "properties":
+				if m.value.kind == '{' {
+					r.renames[n] = r.propertyNames(m.value, p)
+					// Property names are not keywords
+					for _, pm := range m.value.members {
+						r.schemaProperties(pm.value, p+"/"+escapePointer(pm.key))
+					}
+					continue
+				}
+			}
+			r.schemaProperties(m.value, p)
+		}
+	}
+}
+
+func (r *renamer) propertyNames(props *node, pointer string) map[string]string {
+	byName := map[string][]string{}
+	for _, m := range props.members {
+		name := r.convert(m.key)
+		byName[name] = append(byName[name], m.key)
+	}
+	names := make(map[string]string, len(props.members))
+	for name, keys := range byName {
+		if len(keys) > 1 {
+			sort.Strings(keys)
+			r.collisions = append(r.collisions, Collision{Pointer: pointer, Name: name, Keys: keys})
+			for _, k := range keys {
+				names[k] = k
+			}
+			continue
+		}
+		names[keys[0]] = name
+	}
+	return names
+}
+
+// walk Renames instances of "example", "examples", "default" and "const" if instances, else the schema keywords
+func (r *renamer) walk(n *node, instances bool) {
+	switch n.kind {
+	case '[':
+		for _, item := range n.items {
+			r.walk(item, instances)
+		}
+	case '{':
+		for i := range n.members {
+			m := &n.members[i]
+			switch m.key {
+			case "example", "examples", "default", "const":
+				if instances {
+					r.instances(n, m)
+				}
+				continue
+			case "enum":
+				continue
+			case "properties":
+				if instances && m.value.kind == '{' {
+					for _, pm := range m.value.members {
+						r.walk(pm.value, true)
+					}
+					continue
+				}
+			}
+			if instances || !r.keyword(n, m) {
+				r.walk(m.value, instances)
+			}
+		}
+	}
+}
+
+// instances Renames the instances of the member, their schema is the "schema" of media types and parameters, else the object itself
+func (r *renamer) instances(n *node, m *member) {
+	schema := n
+	if s := n.get("schema"); s != nil {
+		schema = s
+	}
+	if m.key != "examples" {
+		r.instance(m.value, schema)
+		return
+	}
+	switch m.value.kind {
+	case '[':
+		for _, item := range m.value.items {
+			r.instance(item, schema)
+		}
+	case '{':
+		// OpenAPI Example Objects
+		for _, em := range m.value.members {
+			if v := em.value.get("value"); v != nil {
+				r.instance(v, schema)
+			}
+		}
+	}
+}
+
+// keyword Renames the schema keyword member of n, reports whether the member is done
+func (r *renamer) keyword(n *node, m *member) bool {
+	names := r.renames[n]
+	switch m.key {
+	case "properties":
+		if m.value.kind != '{' {
+			return false
+		}
+		for j := range m.value.members {
+			pm := &m.value.members[j]
+			if name, ok := names[pm.key]; ok {
+				pm.key = name
+			}
+			r.walk(pm.value, false)
+		}
+	case "required":
+		if m.value.kind != '[' {
+			return false
+		}
+		for _, item := range m.value.items {
+			if s, ok := item.string(); ok {
+				item.setString(r.propertyName(names, s))
+			}
+		}
+	case "discriminator":
+		if m.value.kind != '{' {
+			return false
+		}
+		if pn := m.value.get("propertyName"); pn != nil {
+			if s, ok := pn.string(); ok {
+				pn.setString(r.propertyName(names, s))
+			}
+		}
+		if mapping := m.value.get("mapping"); mapping != nil && mapping.kind == '{' {
+			for _, mm := range mapping.members {
+				r.ref(mm.value)
+			}
+		}
+	case "$ref":
+		r.ref(m.value)
+	default:
+		return false
+	}
+	return true
+}
+
+// propertyName New name of the property of the schema, converted if the schema has no such property
+func (r *renamer) propertyName(names map[string]string, name string) string {
+	if newName, ok := names[name]; ok {
+		return newName
+	}
+	return r.convert(name)
+}
+
+// ref Renames "/properties/" segments of the reference by the new names of the schema they point into.
+// Segments of references to other documents are converted
+func (r *renamer) ref(n *node) {
+	s, ok := n.string()
+	if !ok {
+		return
+	}
+	i := strings.IndexByte(s, '#')
+	if i < 0 {
+		return
+	}
+	segments := strings.Split(s[i+1:], "/")
+	// nodes Targets of the pointer prefixes, nil once a prefix is not found in the document
+	nodes := make([]*node, len(segments))
+	if i == 0 {
+		nodes[0] = r.root
+	}
+	for j := 1; j < len(segments); j++ {
+		segment := unescapePointer(segments[j])
+		name := segment
+		if segments[j-1] == "properties" {
+			if j > 1 && nodes[j-2] != nil {
+				name = r.propertyName(r.renames[nodes[j-2]], segment)
+			} else {
+				name = r.convert(segment)
+			}
+			segments[j] = escapePointer(name)
+		}
+		nodes[j] = child(nodes[j-1], segment, name)
+	}
+	n.setString(s[:i+1] + strings.Join(segments, "/"))
+}
+
+// child Member or item of n by the original name, or by the new name if n is already renamed
+func child(n *node, name, newName string) *node {
+	if n == nil {
+		return nil
+	}
+	switch n.kind {
+	case '{':
+		if c := n.get(name); c != nil {
+			return c
+		}
+		return n.get(newName)
+	case '[':
+		i, err := strconv.Atoi(name)
+		if err != nil || i < 0 || i >= len(n.items) {
+			return nil
+		}
+		return n.items[i]
+	}
+	return nil
+}
+
+// instance Renames object keys of the instance that are properties of the schema
+func (r *renamer) instance(inst, schema *node) {
+	if schema == nil || schema.kind != '{' {
+		return
+	}
+	schemas := r.subschemas(schema, map[*node]bool{})
+	switch inst.kind {
+	case '{':
+		// keys Original keys by their new names
+		keys := make(map[string]string, len(inst.members))
+		for i := range inst.members {
+			m := &inst.members[i]
+			key := m.key
+			var propSchema, additional *node
+			renamed := false
+			for _, s := range schemas {
+				if props := s.get("properties"); props != nil && props.get(m.key) != nil {
+					propSchema = props.get(m.key)
+					if !renamed {
+						if name, ok := r.renames[s][m.key]; ok {
+							m.key = name
+							renamed = true
+						}
+					}
+					break
+				}
+				if ap := s.get("additionalProperties"); ap != nil && additional == nil {
+					additional = ap
+				}
+			}
+			if propSchema == nil {
+				propSchema = additional
+			}
+			if prev, ok := keys[m.key]; ok && prev != key && r.err == nil {
+				r.err = fmt.Errorf("%w: %q and %q of an instance are renamed to %q", ErrDuplicateKey, prev, key, m.key)
+			}
+			keys[m.key] = key
+			r.instance(m.value, propSchema)
+		}
+	case '[':
+		for _, s := range schemas {
+			if items := s.get("items"); items != nil {
+				for _, item := range inst.items {
+					r.instance(item, items)
+				}
+				break
+			}
+		}
+	}
+}
+
+// subschemas The schema, its reference target and allOf, anyOf, oneOf subschemas, each schema once
+func (r *renamer) subschemas(schema *node, seen map[*node]bool) []*node {
+	if schema == nil || schema.kind != '{' || seen[schema] {
+		return nil
+	}
+	seen[schema] = true
+	schemas := []*node{schema}
+	if ref := schema.get("$ref"); ref != nil {
+		if s, ok := ref.string(); ok {
+			schemas = append(schemas, r.subschemas(r.resolve(s), seen)...)
+		}
+	}
+	for _, key := range []string{"allOf", "anyOf", "oneOf"} {
+		if list := schema.get(key); list != nil && list.kind == '[' {
+			for _, item := range list.items {
+				schemas = append(schemas, r.subschemas(item, seen)...)
+			}
+		}
+	}
+	return schemas
+}
+
+// resolve Target of the local reference, original names of the document are looked up
+func (r *renamer) resolve(ref string) *node {
+	if !strings.HasPrefix(ref, "#") {
+		return nil
+	}
+	n := r.root
+	for _, segment := range strings.Split(ref[1:], "/")[1:] {
+		if n == nil {
+			return nil
+		}
+		segment = unescapePointer(segment)
+		switch n.kind {
+		case '{':
+			n = n.get(segment)
+		case '[':
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(n.items) {
+				return nil
+			}
+			n = n.items[i]
+		default:
+			return nil
+		}
+	}
+	return n
+}
+
+func escapePointer(s string) string {
+	return strings.Replace(strings.Replace(s, "~", "~0", -1), "/", "~1", -1)
+}
+
+func unescapePointer(s string) string {
+	return strings.Replace(strings.Replace(s, "~1", "/", -1), "~0", "~", -1)
+}
+
+// node JSON value with object members in document order
+type node struct {
+	// kind '{' object, '[' array, 0 scalar
+	kind    byte
+	members []member
+	items   []*node
+	// raw Scalar as in the document
+	raw json.RawMessage
+}
+
+type member struct {
+	key   string
+	value *node
+}
+
+func parse(src []byte) (*node, error) {
+	dec := json.NewDecoder(bytes.NewReader(src))
+	dec.UseNumber()
+	n, err := parseValue(dec)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("schemacase: data after the document")
+	}
+	return n, nil
+}
+
+// parseValue Parses the next value of dec, each token is read once
+func parseValue(dec *json.Decoder) (*node, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch v := tok.(type) {
+	case json.Delim:
+		n := &node{kind: byte(v)}
+		for dec.More() {
+			if v == '{' {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				value, err := parseValue(dec)
+				if err != nil {
+					return nil, err
+				}
+				n.members = append(n.members, member{key: key.(string), value: value})
+				continue
+			}
+			item, err := parseValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			n.items = append(n.items, item)
+		}
+		// Closing delimiter
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return n, nil
+	case string:
+		return &node{raw: encodeString(v)}, nil
+	case json.Number:
+		return &node{raw: json.RawMessage(v)}, nil
+	case bool:
+		return &node{raw: json.RawMessage(strconv.FormatBool(v))}, nil
+	case nil:
+		return &node{raw: json.RawMessage("null")}, nil
+	}
+	return nil, fmt.Errorf("schemacase: unexpected token %v", tok)
+}
+
+// get Value of the object member
+func (n *node) get(key string) *node {
+	if n == nil || n.kind != '{' {
+		return nil
+	}
+	for _, m := range n.members {
+		if m.key == key {
+			return m.value
+		}
+	}
+	return nil
+}
+
+func (n *node) string() (string, bool) {
+	var s string
+	if n.kind != 0 || len(n.raw) == 0 || n.raw[0] != '"' {
+		return "", false
+	}
+	if err := json.Unmarshal(n.raw, &s); err != nil {
+		return "", false
+	}
+	return s, true
+}
+
+func (n *node) setString(s string) {
+	if old, ok := n.string(); ok && old == s {
+		return
+	}
+	n.raw = encodeString(s)
+}
+
+func encodeString(s string) []byte {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)
+	return bytes.TrimRight(buf.Bytes(), "\n")
+}
+
+// encode Writes compact JSON
+func (n *node) encode(buf *bytes.Buffer) error {
+	switch n.kind {
+	case '{':
+		buf.WriteByte('{')
+		for i, m := range n.members {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.Write(encodeString(m.key))
+			buf.WriteByte(':')
+			if err := m.value.encode(buf); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	case '[':
+		buf.WriteByte('[')
+		for i, item := range n.items {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := item.encode(buf); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	default:
+		buf.Write(n.raw)
+	}
+	return nil
+}
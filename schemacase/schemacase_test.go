@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schemacase
+
+import (
+	"errors"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nikitaksv/strcase"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestRename(t *testing.T) {
+	src, err := ioutil.ReadFile(filepath.Join("testdata", "openapi.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := Rename(src, Config{Convert: strcase.ToCamelCase, Indent: "  "})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden := filepath.Join("testdata", "openapi.golden.json")
+	if *update {
+		if err := ioutil.WriteFile(golden, res.JSON, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res.JSON) != string(want) {
+		t.Errorf("Rename() mismatch (-update to regenerate)\ngot:\n%s\nwant:\n%s", res.JSON, want)
+	}
+
+	wantCollisions := []Collision{{
+		Pointer: "/components/schemas/owner/properties",
+		Name:    "firstName",
+		Keys:    []string{"firstName", "first_name"},
+	}}
+	if !reflect.DeepEqual(res.Collisions, wantCollisions) {
+		t.Errorf("Collisions = %v, want %v", res.Collisions, wantCollisions)
+	}
+}
+
+func TestRenameCompact(t *testing.T) {
+	src := `{"type":"object","required":["user_id"],"properties":{"user_id":{"type":"integer"},"z_a":{}},"example":{"user_id":1.0e1,"z_a":"<&>"}}`
+	res, err := Rename([]byte(src), Config{Convert: strcase.ToCamelCase})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"type":"object","required":["userId"],"properties":{"userId":{"type":"integer"},"zA":{}},"example":{"userId":1.0e1,"zA":"<&>"}}`
+	if string(res.JSON) != want {
+		t.Errorf("Rename() = %s, want %s", res.JSON, want)
+	}
+}
+
+func TestRenameRefs(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "collision",
+			src:  `{"properties":{"user_id":{},"userId":{},"nick_name":{}},"$defs":{"a":{"$ref":"#/properties/user_id"},"b":{"$ref":"#/properties/nick_name"}}}`,
+			want: `{"properties":{"user_id":{},"userId":{},"nickName":{}},"$defs":{"a":{"$ref":"#/properties/user_id"},"b":{"$ref":"#/properties/nickName"}}}`,
+		},
+		{
+			name: "renamed before",
+			src:  `{"$defs":{"s":{"properties":{"a_b":{"properties":{"c_d":{}}}}}},"$ref":"#/$defs/s/properties/a_b/properties/c_d"}`,
+			want: `{"$defs":{"s":{"properties":{"aB":{"properties":{"cD":{}}}}}},"$ref":"#/$defs/s/properties/aB/properties/cD"}`,
+		},
+		{
+			name: "other document",
+			src:  `{"$ref":"other.json#/properties/user_id"}`,
+			want: `{"$ref":"other.json#/properties/userId"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := Rename([]byte(tt.src), Config{Convert: strcase.ToCamelCase})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(res.JSON) != tt.want {
+				t.Errorf("Rename() = %s, want %s", res.JSON, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenameErrors(t *testing.T) {
+	for _, src := range []string{``, `{"a":`, `{} {}`, `[1,]`} {
+		if _, err := Rename([]byte(src), Config{Convert: strcase.ToCamelCase}); err == nil {
+			t.Errorf("Rename(%q) error = nil", src)
+		}
+	}
+	if _, err := Rename([]byte(`{}`), Config{}); err == nil {
+		t.Errorf("Rename() with nil Convert error = nil")
+	}
+}
+
+func TestRenameDuplicateKeys(t *testing.T) {
+	src := `{"properties":{"userId":{}},"example":{"userId":1,"user_id":2}}`
+	_, err := Rename([]byte(src), Config{Convert: strcase.ToSnakeCase})
+	if want := `schemacase: duplicate key: "userId" and "user_id" of an instance are renamed to "user_id"`; !errors.Is(err, ErrDuplicateKey) || err.Error() != want {
+		t.Errorf("Rename() error = %v, want %s", err, want)
+	}
+
+	// Colliding properties are reported and kept
+	src = `{"properties":{"userId":{},"user_id":{}},"example":{"userId":1,"user_id":2}}`
+	res, err := Rename([]byte(src), Config{Convert: strcase.ToSnakeCase})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res.JSON) != src || len(res.Collisions) != 1 {
+		t.Errorf("Rename() = %s, %v, want %s and a collision", res.JSON, res.Collisions, src)
+	}
+}
+
+func TestRenameDeep(t *testing.T) {
+	const depth = 2000
+	src := strings.Repeat(`{"properties":{"a_b":`, depth) + `{}` + strings.Repeat(`}}`, depth)
+	res, err := Rename([]byte(src), Config{Convert: strcase.ToCamelCase})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Repeat(`{"properties":{"aB":`, depth) + `{}` + strings.Repeat(`}}`, depth)
+	if string(res.JSON) != want {
+		t.Errorf("Rename() = %.100s..., want %.100s...", res.JSON, want)
+	}
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"unicode"
+)
+
+const (
+	// scriptCommon Runes without a script of their own (digits, marks, "ー"), they never start a new word.
+	scriptCommon = -1
+	// scriptOther Letters of scripts not listed in wordScripts.
+	scriptOther = -2
+)
+
+// wordScripts Scripts between which ParseRunes inserts a word boundary.
+// Hiragana and Katakana share one entry, so kana words are not split.
+var wordScripts = [][]*unicode.RangeTable{
+	{unicode.Latin},
+	{unicode.Greek},
+	{unicode.Cyrillic},
+	{unicode.Armenian},
+	{unicode.Georgian},
+	{unicode.Hebrew},
+	{unicode.Arabic},
+	{unicode.Devanagari},
+	{unicode.Thai},
+	{unicode.Hangul},
+	{unicode.Han},
+	{unicode.Hiragana, unicode.Katakana},
+}
+
+// scriptOf Returns index of the rune script in wordScripts, scriptOther or scriptCommon
+func scriptOf(r rune) int {
+	if r <= unicode.MaxASCII && unicode.IsLetter(r) {
+		return 0
+	}
+	if !unicode.IsLetter(r) || unicode.In(r, unicode.Common, unicode.Inherited) {
+		return scriptCommon
+	}
+	for i, tables := range wordScripts {
+		if unicode.In(r, tables...) {
+			return i
+		}
+	}
+	return scriptOther
+}
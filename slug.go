@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// SlugOptions Options of ToSlugWithOptions
+type SlugOptions struct {
+	// MaxLength Maximum slug length in bytes, 0 means no limit.
+	// The slug is truncated at a word boundary, a single longer word is cut.
+	MaxLength int
+	// Exists Uniqueness hook, reports whether the slug is already taken.
+	// Taken slugs get a numeric suffix: slug-2, slug-3, ...
+	Exists func(slug string) bool
+	// MaxAttempts Maximum number of Exists calls, DefaultSlugMaxAttempts if 0
+	MaxAttempts int
+}
+
+// DefaultSlugMaxAttempts Default SlugOptions.MaxAttempts
+const DefaultSlugMaxAttempts = 100
+
+// ErrSlugExists Every slug tried by ToSlugWithOptions is taken
+var ErrSlugExists = errors.New("strcase: slug exists")
+
+// ToSlug URL slug ex. "Größe des Kontos!" -> grosse-des-kontos
+func ToSlug(str string) string {
+	return joinSlugWords(slugWords([]rune(str)), 0)
+}
+
+// ToSlugWithOptions URL slug with length limit and uniqueness hook.
+// Returns ErrSlugExists if all of MaxAttempts slugs are taken
+func ToSlugWithOptions(str string, opts SlugOptions) (string, error) {
+	words := slugWords([]rune(str))
+	slug := joinSlugWords(words, opts.MaxLength)
+	if opts.Exists == nil || !opts.Exists(slug) {
+		return slug, nil
+	}
+
+	attempts := opts.MaxAttempts
+	if attempts <= 0 {
+		attempts = DefaultSlugMaxAttempts
+	}
+	for n := 2; n <= attempts; n++ {
+		suffix := string(SeparatorDash) + strconv.Itoa(n)
+		maxLength := 0
+		if opts.MaxLength > 0 {
+			maxLength = opts.MaxLength - len(suffix)
+			if maxLength < 1 {
+				maxLength = 1
+			}
+		}
+		candidate := joinSlugWords(words, maxLength) + suffix
+		if !opts.Exists(candidate) {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s after %d attempts", ErrSlugExists, slug, attempts)
+}
+
+// slugWords Splits transliterated runes into words, unsafe runes are separators
+func slugWords(runes []rune) [][]rune {
+	rs := TransliterateRunes(runes)
+	for i, r := range rs {
+		if !isSlugRune(r) {
+			rs[i] = ' '
+		}
+	}
+	return ParseRunes(rs)
+}
+
+func joinSlugWords(words [][]rune, maxLength int) string {
+	var slug []rune
+	for _, w := range words {
+		if len(slug) == 0 {
+			if maxLength > 0 && len(w) > maxLength {
+				w = w[:maxLength]
+			}
+		} else if maxLength > 0 && len(slug)+1+len(w) > maxLength {
+			break
+		} else {
+			slug = append(slug, SeparatorDash)
+		}
+		slug = append(slug, w...)
+	}
+	return string(slug)
+}
+
+func isSlugRune(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
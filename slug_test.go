@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestToSlug(t *testing.T) {
+	type args struct {
+		str string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "space",
+			args: args{str: "Hello World"},
+			want: "hello-world",
+		},
+		{
+			name: "punctuation",
+			args: args{str: "Don't stop: (me) now!"},
+			want: "don-t-stop-me-now",
+		},
+		{
+			name: "repeated separators",
+			args: args{str: "  a -- b__c..d  "},
+			want: "a-b-c-d",
+		},
+		{
+			name: "transliteration",
+			args: args{str: "Größe des Kontos"},
+			want: "grosse-des-kontos",
+		},
+		{
+			name: "camelCase",
+			args: args{str: "orderItem #42"},
+			want: "order-item-42",
+		},
+		{
+			name: "untransliterable",
+			args: args{str: "user 用户 list"},
+			want: "user-list",
+		},
+		{
+			name: "empty",
+			args: args{str: "?!"},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToSlug(tt.args.str); got != tt.want {
+				t.Errorf("ToSlug() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToSlugWithOptions(t *testing.T) {
+	taken := map[string]bool{
+		"hello-world":   true,
+		"hello-world-2": true,
+	}
+	exists := func(slug string) bool {
+		return taken[slug]
+	}
+
+	tests := []struct {
+		name string
+		str  string
+		opts SlugOptions
+		want string
+	}{
+		{name: "max length word boundary", str: "the quick brown fox", opts: SlugOptions{MaxLength: 12}, want: "the-quick"},
+		{name: "max length exact", str: "the quick brown fox", opts: SlugOptions{MaxLength: 15}, want: "the-quick-brown"},
+		{name: "max length long word", str: "supercalifragilistic word", opts: SlugOptions{MaxLength: 5}, want: "super"},
+		{name: "unique", str: "Hello World", opts: SlugOptions{Exists: exists}, want: "hello-world-3"},
+		{name: "unique free", str: "Hello there", opts: SlugOptions{Exists: exists}, want: "hello-there"},
+		{name: "unique max length", str: "Hello World", opts: SlugOptions{MaxLength: 11, Exists: exists}, want: "hello-2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToSlugWithOptions(tt.str, tt.opts)
+			if err != nil || got != tt.want {
+				t.Errorf("ToSlugWithOptions() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+
+	calls := 0
+	always := func(string) bool {
+		calls++
+		return true
+	}
+	if got, err := ToSlugWithOptions("Hello World", SlugOptions{Exists: always}); !errors.Is(err, ErrSlugExists) || calls != DefaultSlugMaxAttempts {
+		t.Errorf("ToSlugWithOptions() = %v, %v after %d calls, want ErrSlugExists after %d", got, err, calls, DefaultSlugMaxAttempts)
+	}
+	calls = 0
+	if _, err := ToSlugWithOptions("Hello World", SlugOptions{Exists: always, MaxAttempts: 3}); !errors.Is(err, ErrSlugExists) || calls != 3 {
+		t.Errorf("ToSlugWithOptions() error = %v after %d calls, want ErrSlugExists after 3", err, calls)
+	}
+}
@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SQLDialect SQL database dialect of identifiers
+type SQLDialect int
+
+// SQL dialects accepted by ToSQLIdentifier
+const (
+	DialectPostgres SQLDialect = iota
+	DialectMySQL
+	DialectSQLite
+	DialectSQLServer
+	DialectOracle
+)
+
+func (d SQLDialect) String() string {
+	switch d {
+	case DialectPostgres:
+		return "postgres"
+	case DialectMySQL:
+		return "mysql"
+	case DialectSQLite:
+		return "sqlite"
+	case DialectSQLServer:
+		return "sqlserver"
+	case DialectOracle:
+		return "oracle"
+	}
+	return fmt.Sprintf("SQLDialect(%d)", int(d))
+}
+
+// sqlHashLen Length of the "_" and hash suffix of truncated identifiers
+const sqlHashLen = 9
+
+// maxLen Limit of identifiers, in bytes for Postgres and Oracle, in characters for MySQL and SQL Server, 0 if none
+func (d SQLDialect) maxLen() (n int, bytes bool) {
+	switch d {
+	case DialectPostgres:
+		return 63, true
+	case DialectMySQL:
+		return 64, false
+	case DialectSQLServer:
+		return 128, false
+	case DialectOracle:
+		return 128, true
+	}
+	return 0, false
+}
+
+// quotes Opening and closing quote of identifiers
+func (d SQLDialect) quotes() (byte, byte) {
+	switch d {
+	case DialectMySQL:
+		return '`', '`'
+	case DialectSQLServer:
+		return '[', ']'
+	}
+	return '"', '"'
+}
+
+// ToSQLIdentifier snake_case identifier for the dialect. Ex. ToSQLIdentifier("UserID", DialectPostgres) -> user_id
+//
+// Identifiers are upper case for Oracle: USER_ID.
+// Identifiers longer than the dialect limit are truncated and get a hash suffix of the full name: "long_name_3f2a9c1b".
+// Reserved words, names with characters not allowed unquoted and names changed by case folding
+// (upper letters for Postgres) are quoted: "order", `order`, [order].
+func ToSQLIdentifier(str string, dialect SQLDialect) string {
+	name := ToSnakeCase(str)
+	if dialect == DialectOracle {
+		name = strings.ToUpper(name)
+	}
+	name = truncateSQLIdentifier(name, dialect)
+	if name == "" || !needsSQLQuotes(name, dialect) {
+		return name
+	}
+	open, closing := dialect.quotes()
+	return string(open) + strings.Replace(name, string(closing), string(closing)+string(closing), -1) + string(closing)
+}
+
+// ErrSQLIdentifier Invalid quoted identifier
+var ErrSQLIdentifier = errors.New("strcase: invalid quoted SQL identifier")
+
+// UnquoteSQLIdentifier Identifier without quotes, inverse of ToSQLIdentifier quoting. Unquoted identifiers are returned as is
+func UnquoteSQLIdentifier(str string, dialect SQLDialect) (string, error) {
+	open, closing := dialect.quotes()
+	if str == "" || str[0] != open {
+		return str, nil
+	}
+	if len(str) < 2 || str[len(str)-1] != closing {
+		return "", fmt.Errorf("%w: %s", ErrSQLIdentifier, str)
+	}
+	inner := str[1 : len(str)-1]
+	var b strings.Builder
+	for i := 0; i < len(inner); i++ {
+		if inner[i] == closing {
+			if i+1 == len(inner) || inner[i+1] != closing {
+				return "", fmt.Errorf("%w: %s", ErrSQLIdentifier, str)
+			}
+			i++
+		}
+		b.WriteByte(inner[i])
+	}
+	return b.String(), nil
+}
+
+func truncateSQLIdentifier(name string, dialect SQLDialect) string {
+	max, inBytes := dialect.maxLen()
+	size := utf8.RuneCountInString(name)
+	if inBytes {
+		size = len(name)
+	}
+	if max == 0 || size <= max {
+		return name
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	var prefix strings.Builder
+	n := 0
+	for _, r := range name {
+		rSize := 1
+		if inBytes {
+			rSize = utf8.RuneLen(r)
+		}
+		if n+rSize > max-sqlHashLen {
+			break
+		}
+		prefix.WriteRune(r)
+		n += rSize
+	}
+	format := "%s_%08x"
+	if dialect == DialectOracle {
+		format = "%s_%08X"
+	}
+	return fmt.Sprintf(format, strings.TrimRight(prefix.String(), "_"), h.Sum32())
+}
+
+func needsSQLQuotes(name string, dialect SQLDialect) bool {
+	if sqlReserved[dialect][strings.ToUpper(name)] {
+		return true
+	}
+	for i, r := range name {
+		switch {
+		case r == '_':
+			if i == 0 && dialect == DialectOracle {
+				return true
+			}
+		case unicode.IsDigit(r):
+			if i == 0 {
+				return true
+			}
+		case r == '$':
+			if i == 0 || dialect == DialectSQLServer {
+				return true
+			}
+		case unicode.IsLetter(r):
+			if dialect == DialectPostgres && unicode.IsUpper(r) || dialect == DialectOracle && unicode.IsLower(r) {
+				return true
+			}
+		default:
+			return true
+		}
+	}
+	return false
+}
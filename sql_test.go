@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestToSQLIdentifier(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect SQLDialect
+		want    string
+	}{
+		{"CreatedAt", DialectPostgres, "created_at"},
+		{"Order", DialectPostgres, `"order"`},
+		{"userID", DialectPostgres, "user_id"},
+		{"UserID", DialectPostgres, "user_id"},
+		{"2faCode", DialectPostgres, `"2fa_code"`},
+		{"Order", DialectMySQL, "`order`"},
+		{"userID", DialectMySQL, "user_id"},
+		{"HTTPServerURL", DialectSQLServer, "http_server_url"},
+		{"Key", DialectMySQL, "`key`"},
+		{"Key", DialectPostgres, "key"},
+		{"Pragma", DialectSQLite, `"pragma"`},
+		{"Order", DialectSQLServer, "[order]"},
+		{"a]b", DialectSQLServer, "[a]]b]"},
+		{"userID", DialectOracle, "USER_ID"},
+		{"Comment", DialectOracle, `"COMMENT"`},
+		{"", DialectPostgres, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect.String()+"/"+tt.name, func(t *testing.T) {
+			if got := ToSQLIdentifier(tt.name, tt.dialect); got != tt.want {
+				t.Errorf("ToSQLIdentifier() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToSQLIdentifierTruncate(t *testing.T) {
+	long := strings.Repeat("VeryLongColumnName", 10)
+	tests := []struct {
+		dialect SQLDialect
+		max     int
+		bytes   bool
+	}{
+		{DialectPostgres, 63, true},
+		{DialectMySQL, 64, false},
+		{DialectSQLServer, 128, false},
+		{DialectOracle, 128, true},
+	}
+	for _, tt := range tests {
+		for _, name := range []string{long, strings.Repeat("Größe", 40)} {
+			got, err := UnquoteSQLIdentifier(ToSQLIdentifier(name, tt.dialect), tt.dialect)
+			if err != nil {
+				t.Fatal(err)
+			}
+			size := utf8.RuneCountInString(got)
+			if tt.bytes {
+				size = len(got)
+			}
+			if size != tt.max && !(size < tt.max && size > tt.max-4) {
+				t.Errorf("ToSQLIdentifier(%v) = %v, size %d, want %d", tt.dialect, got, size, tt.max)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("ToSQLIdentifier(%v) = %q, invalid UTF-8", tt.dialect, got)
+			}
+			if a, b := ToSQLIdentifier(name, tt.dialect), ToSQLIdentifier(name, tt.dialect); a != b {
+				t.Errorf("ToSQLIdentifier(%v) is not deterministic: %v != %v", tt.dialect, a, b)
+			}
+		}
+		if ToSQLIdentifier(long+"A", tt.dialect) == ToSQLIdentifier(long+"B", tt.dialect) {
+			t.Errorf("ToSQLIdentifier(%v) truncated names collide", tt.dialect)
+		}
+	}
+	if got := ToSQLIdentifier(long, DialectSQLite); got != ToSnakeCase(long) {
+		t.Errorf("ToSQLIdentifier(sqlite) = %v, want not truncated", got)
+	}
+}
+
+func TestUnquoteSQLIdentifier(t *testing.T) {
+	for d := DialectPostgres; d <= DialectOracle; d++ {
+		for _, name := range []string{"order", "user_ID", "a]b`c\"d", "created_at"} {
+			want := strings.ToLower(name)
+			if d == DialectOracle {
+				want = strings.ToUpper(name)
+			}
+			quoted := ToSQLIdentifier(name, d)
+			got, err := UnquoteSQLIdentifier(quoted, d)
+			if err != nil {
+				t.Fatalf("UnquoteSQLIdentifier(%v, %v) error = %v", quoted, d, err)
+			}
+			if got != want {
+				t.Errorf("UnquoteSQLIdentifier(%v, %v) = %v, want %v", quoted, d, got, want)
+			}
+		}
+	}
+
+	tests := []struct {
+		in      string
+		dialect SQLDialect
+		want    string
+		wantErr bool
+	}{
+		{`"a""b"`, DialectPostgres, `a"b`, false},
+		{"`a``b`", DialectMySQL, "a`b", false},
+		{"[a]]b]", DialectSQLServer, "a]b", false},
+		{"plain", DialectPostgres, "plain", false},
+		{`"open`, DialectPostgres, "", true},
+		{`"a"b"`, DialectPostgres, "", true},
+		{"[a]b]", DialectSQLServer, "", true},
+	}
+	for _, tt := range tests {
+		got, err := UnquoteSQLIdentifier(tt.in, tt.dialect)
+		if (err != nil) != tt.wantErr || tt.wantErr && !errors.Is(err, ErrSQLIdentifier) {
+			t.Errorf("UnquoteSQLIdentifier(%v) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("UnquoteSQLIdentifier(%v) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
@@ -17,6 +17,7 @@
 package strcase
 
 import (
+	"sync"
 	"unicode"
 )
 
@@ -118,12 +119,12 @@ func ToCamelCaseAcronym(str string) string {
 
 // ToCamelCaseRunes CamelCase ex. camelCase
 func ToCamelCaseRunes(runes []rune) []rune {
-	return camelCase(runes, false, false)
+	return camelCase(ParseRunes(runes), false, nil, toTitleRunes)
 }
 
 // ToCamelCaseAcronymRunes Replace acronym in slice of runes. Ex. camelCaseID
 func ToCamelCaseAcronymRunes(runes []rune) []rune {
-	return camelCase(runes, false, true)
+	return camelCase(ParseRunes(runes), false, acrMap, toTitleRunes)
 }
 
 // ToPascalCase PascalCase ex. PascalCase
@@ -138,12 +139,92 @@ func ToPascalCaseAcronym(str string) string {
 
 // ToPascalCaseRunes PascalCase ex. PascalCase
 func ToPascalCaseRunes(runes []rune) []rune {
-	return camelCase(runes, true, false)
+	return camelCase(ParseRunes(runes), true, nil, toTitleRunes)
 }
 
 // ToPascalCaseAcronymRunes Replace acronym in slice of runes. Ex. PascalCaseID
 func ToPascalCaseAcronymRunes(runes []rune) []rune {
-	return camelCase(runes, true, true)
+	return camelCase(ParseRunes(runes), true, acrMap, toTitleRunes)
+}
+
+// ToScreamingSnakeCase ScreamingSnakeCase ex. SCREAMING_SNAKE_CASE
+func ToScreamingSnakeCase(str string) string {
+	return string(ToScreamingSnakeCaseRunes([]rune(str)))
+}
+
+// ToScreamingSnakeCaseAcronym Replace acronym in string. Ex. SCREAMING_SNAKE_CASE_IDs
+func ToScreamingSnakeCaseAcronym(str string) string {
+	return string(ToScreamingSnakeCaseAcronymRunes([]rune(str)))
+}
+
+// ToScreamingSnakeCaseRunes ScreamingSnakeCase ex. SCREAMING_SNAKE_CASE
+func ToScreamingSnakeCaseRunes(runes []rune) []rune {
+	return wordsCase(ParseRunes(runes), []rune{SeparatorUnderscore}, nil, upperWord)
+}
+
+// ToScreamingSnakeCaseAcronymRunes Replace acronym in slice of runes. Ex. SCREAMING_SNAKE_CASE_IDs
+func ToScreamingSnakeCaseAcronymRunes(runes []rune) []rune {
+	return wordsCase(ParseRunes(runes), []rune{SeparatorUnderscore}, acrMap, upperWord)
+}
+
+// ToTitleCase TitleCase ex. Title Case
+func ToTitleCase(str string) string {
+	return string(ToTitleCaseRunes([]rune(str)))
+}
+
+// ToTitleCaseAcronym Replace acronym in string. Ex. Title Case ID
+func ToTitleCaseAcronym(str string) string {
+	return string(ToTitleCaseAcronymRunes([]rune(str)))
+}
+
+// ToTitleCaseRunes TitleCase ex. Title Case
+func ToTitleCaseRunes(runes []rune) []rune {
+	return wordsCase(ParseRunes(runes), []rune{' '}, nil, titleWord)
+}
+
+// ToTitleCaseAcronymRunes Replace acronym in slice of runes. Ex. Title Case ID
+func ToTitleCaseAcronymRunes(runes []rune) []rune {
+	return wordsCase(ParseRunes(runes), []rune{' '}, acrMap, titleWord)
+}
+
+// ToTrainCase TrainCase ex. Train-Case
+func ToTrainCase(str string) string {
+	return string(ToTrainCaseRunes([]rune(str)))
+}
+
+// ToTrainCaseAcronym Replace acronym in string. Ex. Train-Case-ID
+func ToTrainCaseAcronym(str string) string {
+	return string(ToTrainCaseAcronymRunes([]rune(str)))
+}
+
+// ToTrainCaseRunes TrainCase ex. Train-Case
+func ToTrainCaseRunes(runes []rune) []rune {
+	return wordsCase(ParseRunes(runes), []rune{SeparatorDash}, nil, titleWord)
+}
+
+// ToTrainCaseAcronymRunes Replace acronym in slice of runes. Ex. Train-Case-ID
+func ToTrainCaseAcronymRunes(runes []rune) []rune {
+	return wordsCase(ParseRunes(runes), []rune{SeparatorDash}, acrMap, titleWord)
+}
+
+// ToSentenceCase SentenceCase ex. Sentence case
+func ToSentenceCase(str string) string {
+	return string(ToSentenceCaseRunes([]rune(str)))
+}
+
+// ToSentenceCaseAcronym Replace acronym in string. Ex. Sentence case ID
+func ToSentenceCaseAcronym(str string) string {
+	return string(ToSentenceCaseAcronymRunes([]rune(str)))
+}
+
+// ToSentenceCaseRunes SentenceCase ex. Sentence case
+func ToSentenceCaseRunes(runes []rune) []rune {
+	return wordsCase(ParseRunes(runes), []rune{' '}, nil, sentenceWord)
+}
+
+// ToSentenceCaseAcronymRunes Replace acronym in slice of runes. Ex. Sentence case ID
+func ToSentenceCaseAcronymRunes(runes []rune) []rune {
+	return wordsCase(ParseRunes(runes), []rune{' '}, acrMap, sentenceWord)
 }
 
 // ParseString Splits the input line into words.
@@ -156,12 +237,21 @@ func ParseString(str string) []string {
 	return words
 }
 
-// ParseRunes Splits the input line into words
+// ParseRunes Splits the input line into words.
+// A script change between letters (ex. Han, Kana, Latin, Cyrillic) also starts a new word: "用户ID" -> "用户","id"
 func ParseRunes(rs []rune) [][]rune {
 	var words [][]rune
 
 	var word []rune
-	for _, r := range rs {
+	wordScript := scriptCommon
+	for i, r := range rs {
+		if script := scriptOf(r); script != scriptCommon {
+			if len(word) > 0 && wordScript != scriptCommon && wordScript != script {
+				words = append(words, toLowerRunes(word))
+				word = []rune{}
+			}
+			wordScript = script
+		}
 		if isDelimiter(r) {
 			if unicode.IsLetter(r) {
 				if len(word) > 0 && !isAllUpper(word) {
@@ -172,8 +262,13 @@ func ParseRunes(rs []rune) [][]rune {
 			} else if len(word) > 0 {
 				words = append(words, toLowerRunes(word))
 				word = []rune{}
+				wordScript = scriptCommon
 			}
 		} else {
+			if isAcronymRunEnd(word, rs[i:]) {
+				words = append(words, toLowerRunes(word[:len(word)-1]))
+				word = []rune{word[len(word)-1]}
+			}
 			word = append(word, r)
 		}
 	}
@@ -184,25 +279,31 @@ func ParseRunes(rs []rune) [][]rune {
 	return words
 }
 
-func camelCase(runes []rune, upper bool, replaceAcronym bool) []rune {
-	camelCase := make([]rune, 0, len(runes))
-	for i, rs := range ParseRunes(runes) {
+// isAcronymRunEnd Lowercase rest after an upper run starts a new word from the last upper letter: "HTTPPort" -> "HTTP","Port".
+// A single "s" at the end of the word or before an upper letter keeps the plural of the acronym: "UUIDs", "APIsList"
+func isAcronymRunEnd(word []rune, rest []rune) bool {
+	if len(word) < 3 || !unicode.IsLower(rest[0]) || !isAllUpper(word) {
+		return false
+	}
+	return rest[0] != 's' || len(rest) > 1 && unicode.IsLower(rest[1])
+}
+
+// camelCase Joins words capitalized with title, the first word is lower case unless upper
+func camelCase(words [][]rune, upper bool, acronyms *sync.Map, title func([]rune) []rune) []rune {
+	var camelCase []rune
+	for i, rs := range words {
 		var nRs []rune
 		var foundReplace bool
-		if replaceAcronym {
-			nRs, foundReplace = ReplaceAcronymRunes(rs)
+		if acronyms != nil {
+			nRs, foundReplace = replaceAcronymRunes(acronyms, rs, false)
 		}
 
 		if foundReplace {
 			rs = nRs
-		} else if i == 0 {
-			if upper {
-				rs[0] = unicode.ToUpper(rs[0])
-			} else {
-				rs[0] = unicode.ToLower(rs[0])
-			}
-		} else if i > 0 {
-			rs[0] = unicode.ToUpper(rs[0])
+		} else if i == 0 && !upper {
+			rs[0] = unicode.ToLower(rs[0])
+		} else {
+			rs = title(rs)
 		}
 
 		camelCase = append(camelCase, rs...)
@@ -211,12 +312,42 @@ func camelCase(runes []rune, upper bool, replaceAcronym bool) []rune {
 	return camelCase
 }
 
+// wordsCase Converts each word with f unless it is an acronym and joins words with sep
+func wordsCase(words [][]rune, sep []rune, acronyms *sync.Map, f func(i int, word []rune) []rune) []rune {
+	for i, w := range words {
+		if acronyms != nil {
+			if acr, found := replaceAcronymRunes(acronyms, w, false); found {
+				words[i] = acr
+				continue
+			}
+		}
+		words[i] = f(i, w)
+	}
+	return concatRuneWords(words, sep)
+}
+
+func upperWord(_ int, word []rune) []rune {
+	return toUpperRunes(word)
+}
+
+func titleWord(_ int, word []rune) []rune {
+	return toTitleRunes(word)
+}
+
+func sentenceWord(i int, word []rune) []rune {
+	if i == 0 {
+		return toTitleRunes(word)
+	}
+	return word
+}
+
 func isDelimiter(r rune) bool {
 	return r == SeparatorDot ||
 		r == SeparatorDash ||
 		r == SeparatorUnderscore ||
 		unicode.IsSpace(r) ||
-		unicode.IsUpper(r)
+		unicode.IsUpper(r) ||
+		unicode.IsTitle(r)
 }
 
 // All runes isUppercase
@@ -266,23 +397,27 @@ func ReplaceAcronym(word string) (string, bool) {
 }
 
 func ReplaceAcronymsRunes(runeWords [][]rune) [][]rune {
-	for i, rw := range runeWords {
-		runeWords[i], _ = ReplaceAcronymRunes(rw)
-	}
-	return runeWords
+	return replaceAcronymsRunes(acrMap, runeWords)
 }
 
 func ReplaceAcronymRunes(runeWord []rune) ([]rune, bool) {
-	return replaceAcronymRunes(runeWord, false)
+	return replaceAcronymRunes(acrMap, runeWord, false)
+}
+
+func replaceAcronymsRunes(acronyms *sync.Map, runeWords [][]rune) [][]rune {
+	for i, rw := range runeWords {
+		runeWords[i], _ = replaceAcronymRunes(acronyms, rw, false)
+	}
+	return runeWords
 }
 
-func replaceAcronymRunes(runeWord []rune, exit bool) ([]rune, bool) {
-	if acr, ok := acrMap.Load(string(runeWord)); ok {
+func replaceAcronymRunes(acronyms *sync.Map, runeWord []rune, exit bool) ([]rune, bool) {
+	if acr, ok := acronyms.Load(string(runeWord)); ok {
 		if acr, ok := acr.([]rune); ok {
 			return acr, true
 		}
 	} else if !exit {
-		return replaceAcronymRunes(toLowerRunes(runeWord), true)
+		return replaceAcronymRunes(acronyms, toLowerRunes(runeWord), true)
 	}
 	return runeWord, false
 }
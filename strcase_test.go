@@ -18,6 +18,7 @@ package strcase
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -117,6 +118,41 @@ func TestToCamelCase(t *testing.T) {
 			args: args{str: "field.Name"},
 			want: "fieldName",
 		},
+		{
+			name: "caseless",
+			args: args{str: "ID 用户 名称"},
+			want: "id用户名称",
+		},
+		{
+			name: "caseless first",
+			args: args{str: "用户_id"},
+			want: "用户Id",
+		},
+		{
+			name: "acronym run HTTPServer",
+			args: args{str: "HTTPServer"},
+			want: "httpServer",
+		},
+		{
+			name: "acronym run XMLHttpRequest",
+			args: args{str: "XMLHttpRequest"},
+			want: "xmlHttpRequest",
+		},
+		{
+			name: "acronym run UserIDs",
+			args: args{str: "UserIDs"},
+			want: "userIds",
+		},
+		{
+			name: "acronym run APIsList",
+			args: args{str: "APIsList"},
+			want: "apisList",
+		},
+		{
+			name: "acronym run IDCard",
+			args: args{str: "IDCard"},
+			want: "idCard",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -181,6 +217,31 @@ func TestToPascalCase(t *testing.T) {
 			args: args{str: "field.Name"},
 			want: "FieldName",
 		},
+		{
+			name: "acronym run HTTPServer",
+			args: args{str: "HTTPServer"},
+			want: "HttpServer",
+		},
+		{
+			name: "acronym run XMLHttpRequest",
+			args: args{str: "XMLHttpRequest"},
+			want: "XmlHttpRequest",
+		},
+		{
+			name: "acronym run UserIDs",
+			args: args{str: "UserIDs"},
+			want: "UserIds",
+		},
+		{
+			name: "acronym run APIsList",
+			args: args{str: "APIsList"},
+			want: "ApisList",
+		},
+		{
+			name: "acronym run IDCard",
+			args: args{str: "IDCard"},
+			want: "IdCard",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -245,6 +306,31 @@ func TestToSnakeCase(t *testing.T) {
 			args: args{str: "field.Name"},
 			want: "field_name",
 		},
+		{
+			name: "acronym run HTTPServer",
+			args: args{str: "HTTPServer"},
+			want: "http_server",
+		},
+		{
+			name: "acronym run XMLHttpRequest",
+			args: args{str: "XMLHttpRequest"},
+			want: "xml_http_request",
+		},
+		{
+			name: "acronym run UserIDs",
+			args: args{str: "UserIDs"},
+			want: "user_ids",
+		},
+		{
+			name: "acronym run APIsList",
+			args: args{str: "APIsList"},
+			want: "apis_list",
+		},
+		{
+			name: "acronym run IDCard",
+			args: args{str: "IDCard"},
+			want: "id_card",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -349,6 +435,71 @@ func TestParseString(t *testing.T) {
 			args: args{str: " field name "},
 			want: []string{"field", "name"},
 		},
+		{
+			name: "han latin",
+			args: args{str: "用户ID"},
+			want: []string{"用户", "id"},
+		},
+		{
+			name: "latin han",
+			args: args{str: "ID用户"},
+			want: []string{"id", "用户"},
+		},
+		{
+			name: "katakana latin",
+			args: args{str: "ユーザーName"},
+			want: []string{"ユーザー", "name"},
+		},
+		{
+			name: "hiragana katakana",
+			args: args{str: "ひらがなカタカナ"},
+			want: []string{"ひらがなカタカナ"},
+		},
+		{
+			name: "han kana",
+			args: args{str: "漢字カナ"},
+			want: []string{"漢字", "カナ"},
+		},
+		{
+			name: "lower latin cyrillic",
+			args: args{str: "userимя"},
+			want: []string{"user", "имя"},
+		},
+		{
+			name: "arabic digit",
+			args: args{str: "حساب2name"},
+			want: []string{"حساب2", "name"},
+		},
+		{
+			name: "hebrew",
+			args: args{str: "שם_משתמש"},
+			want: []string{"שם", "משתמש"},
+		},
+		{
+			name: "thai",
+			args: args{str: "ชื่อuser"},
+			want: []string{"ชื่อ", "user"},
+		},
+		{
+			name: "acronym run",
+			args: args{str: "HTTPPortJSONData"},
+			want: []string{"http", "port", "json", "data"},
+		},
+		{
+			name: "acronym plural",
+			args: args{str: "UserIDs URLsList"},
+			want: []string{"user", "ids", "urls", "list"},
+		},
+		{
+			name: "acronym plural at end",
+			args: args{str: "UUIDs APIs"},
+			want: []string{"uuids", "apis"},
+		},
+		{
+			name: "acronym run before s word",
+			args: args{str: "APIsettings"},
+			want: []string{"ap", "isettings"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -486,6 +637,84 @@ func TestToMergeCase(t *testing.T) {
 		})
 	}
 }
+func TestToScreamingSnakeCase(t *testing.T) {
+	tests := []struct {
+		str  string
+		want string
+	}{
+		{str: "field name", want: "FIELD_NAME"},
+		{str: "FIELD_NAME", want: "FIELD_NAME"},
+		{str: "field-name", want: "FIELD_NAME"},
+		{str: "fieldName", want: "FIELD_NAME"},
+		{str: "Field.Name", want: "FIELD_NAME"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.str, func(t *testing.T) {
+			if got := ToScreamingSnakeCase(tt.str); got != tt.want {
+				t.Errorf("ToScreamingSnakeCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToTitleCase(t *testing.T) {
+	tests := []struct {
+		str  string
+		want string
+	}{
+		{str: "field name", want: "Field Name"},
+		{str: "FIELD_NAME", want: "Field Name"},
+		{str: "field-name", want: "Field Name"},
+		{str: "fieldName", want: "Field Name"},
+		{str: "field.name.id", want: "Field Name Id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.str, func(t *testing.T) {
+			if got := ToTitleCase(tt.str); got != tt.want {
+				t.Errorf("ToTitleCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToTrainCase(t *testing.T) {
+	tests := []struct {
+		str  string
+		want string
+	}{
+		{str: "field name", want: "Field-Name"},
+		{str: "FIELD_NAME", want: "Field-Name"},
+		{str: "x-request-id", want: "X-Request-Id"},
+		{str: "contentType", want: "Content-Type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.str, func(t *testing.T) {
+			if got := ToTrainCase(tt.str); got != tt.want {
+				t.Errorf("ToTrainCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToSentenceCase(t *testing.T) {
+	tests := []struct {
+		str  string
+		want string
+	}{
+		{str: "field name", want: "Field name"},
+		{str: "FIELD_NAME", want: "Field name"},
+		{str: "maxRetryCount", want: "Max retry count"},
+		{str: "HTTPPort", want: "Http port"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.str, func(t *testing.T) {
+			if got := ToSentenceCase(tt.str); got != tt.want {
+				t.Errorf("ToSentenceCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestToMergeCaseAcronym(t *testing.T) {
 	type args struct {
 		str string
@@ -532,6 +761,30 @@ func TestToMergeCaseAcronym(t *testing.T) {
 			f:    ToPascalCaseAcronym,
 			want: "OrderID",
 		},
+		{
+			name: "SCREAMING_SNAKE_CASE",
+			args: args{str: "orderIds"},
+			f:    ToScreamingSnakeCaseAcronym,
+			want: "ORDER_IDs",
+		},
+		{
+			name: "Title Case",
+			args: args{str: "order_id"},
+			f:    ToTitleCaseAcronym,
+			want: "Order ID",
+		},
+		{
+			name: "Sentence case",
+			args: args{str: "OrderId"},
+			f:    ToSentenceCaseAcronym,
+			want: "Order ID",
+		},
+		{
+			name: "Train-Case",
+			args: args{str: "order_id"},
+			f:    ToTrainCaseAcronym,
+			want: "Order-ID",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -542,6 +795,109 @@ func TestToMergeCaseAcronym(t *testing.T) {
 	}
 }
 
+func TestTitleCaseUnicode(t *testing.T) {
+	type args struct {
+		str string
+	}
+	tests := []struct {
+		name string
+		args args
+		f    func(string) string
+		want string
+	}{
+		{
+			name: "digraph dz PascalCase",
+			args: args{str: "\u01C6ungla"},
+			f:    ToPascalCase,
+			want: "\u01C5ungla",
+		},
+		{
+			name: "digraph dz upper PascalCase",
+			args: args{str: "\u01C4UNGLA"},
+			f:    ToPascalCase,
+			want: "\u01C5ungla",
+		},
+		{
+			name: "digraph lj camelCase",
+			args: args{str: "field \u01C9ubav"},
+			f:    ToCamelCase,
+			want: "field\u01C8ubav",
+		},
+		{
+			name: "digraph nj camelCase first word",
+			args: args{str: "\u01CAego field"},
+			f:    ToCamelCase,
+			want: "\u01CCegoField",
+		},
+		{
+			name: "titlecase digraph starts a word",
+			args: args{str: "field\u01C5ungla"},
+			f:    ToSnakeCase,
+			want: "field_\u01C6ungla",
+		},
+		{
+			name: "sharp s without full case mapping",
+			args: args{str: "stra\u00DFe \u00DFtop"},
+			f:    ToPascalCase,
+			want: "Stra\u00DFe\u00DFtop",
+		},
+		{
+			name: "cyrillic",
+			args: args{str: "\u043F\u043E\u043B\u0435 \u0438\u043C\u044F"},
+			f:    ToPascalCase,
+			want: "\u041F\u043E\u043B\u0435\u0418\u043C\u044F",
+		},
+		{
+			name: "greek",
+			args: args{str: "\u03CC\u03BD\u03BF\u03BC\u03B1 \u03C0\u03B5\u03B4\u03AF\u03BF\u03C5"},
+			f:    ToCamelCase,
+			want: "\u03CC\u03BD\u03BF\u03BC\u03B1\u03A0\u03B5\u03B4\u03AF\u03BF\u03C5",
+		},
+		{
+			name: "armenian",
+			args: args{str: "\u0561\u0576\u0578\u0582\u0576"},
+			f:    ToPascalCase,
+			want: "\u0531\u0576\u0578\u0582\u0576",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f(tt.args.str); got != tt.want {
+				t.Errorf("%s() = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConverterFullCaseMapping(t *testing.T) {
+	c := NewConverter(nil)
+	c.SetFullCaseMapping(true)
+
+	tests := []struct {
+		name string
+		str  string
+		f    func(string) string
+		want string
+	}{
+		{name: "sharp s", str: "stra\u00DFe \u00DFtop", f: c.ToPascalCase, want: "Stra\u00DFeSstop"},
+		{name: "sharp s first word camelCase", str: "\u00DFtop field", f: c.ToCamelCase, want: "\u00DFtopField"},
+		{name: "ligature fi", str: "\uFB01le name", f: c.ToPascalCase, want: "FileName"},
+		{name: "ligature ffl", str: "my \uFB04ow", f: c.ToCamelCase, want: "myFflow"},
+		{name: "armenian ech yiwn", str: "\u0587", f: c.ToPascalCase, want: "\u0535\u0582"},
+		{name: "digraph stays titlecase", str: "\u01C6ungla", f: c.ToPascalCase, want: "\u01C5ungla"},
+		{name: "title case", str: "\u00DFtop \uFB01le", f: c.ToTitleCase, want: "Sstop File"},
+		{name: "sentence case", str: "\u00DFtop \uFB01le", f: c.ToSentenceCase, want: "Sstop \uFB01le"},
+		{name: "global unchanged", str: "\uFB01le name", f: ToPascalCase, want: "\uFB01leName"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f(tt.str); got != tt.want {
+				t.Errorf("%s() = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestReplaceAcronyms(t *testing.T) {
 	words := []string{"order", "id"}
 	want := []string{"order", "ID"}
@@ -576,6 +932,18 @@ func TestAddAcronym(t *testing.T) {
 	}
 }
 
+func TestReadAcronyms(t *testing.T) {
+	err := ReadAcronyms(strings.NewReader("# comment\nGUID Guid guid\n\n  SSL # secure sockets\n"))
+	if err != nil {
+		t.Fatalf("ReadAcronyms() error = %v", err)
+	}
+	for variant, want := range map[string]string{"Guid": "GUID", "guid": "GUID", "ssl": "SSL"} {
+		if got, found := ReplaceAcronym(variant); got != want || !found {
+			t.Errorf("ReplaceAcronym(%q) = %v (%t), want %v (true)", variant, got, found, want)
+		}
+	}
+}
+
 func TestIsLower(t *testing.T) {
 	w := "qwerTy"
 	if isLower([]rune(w)) {
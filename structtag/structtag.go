@@ -0,0 +1,261 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package structtag adds and rewrites struct tags derived from field names.
+//
+//	type User struct {
+//		UserID    int    `json:",omitempty"`
+//		FirstName string `db:"name"`
+//	}
+//
+// with tags json=camel,db=snake becomes
+//
+//	type User struct {
+//		UserID    int    `json:"userId,omitempty" db:"user_id"`
+//		FirstName string `db:"name" json:"firstName"`
+//	}
+package structtag
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/nikitaksv/strcase"
+)
+
+// Tag Tag key and converter of field names
+type Tag struct {
+	Key     string
+	Convert func(string) string
+}
+
+// Config Tags configuration
+type Config struct {
+	Tags []Tag
+	// Struct Name of the struct to process, all structs if empty
+	Struct string
+	// Overwrite Rewrites explicitly set names, options are preserved
+	Overwrite bool
+}
+
+// ParseTags Parses tags spec "key=case,...", case is a strcase.CaseFunc name. Ex. "json=camel,db=snake,yaml=kebab"
+func ParseTags(spec string, acronym bool) ([]Tag, error) {
+	var tags []Tag
+	for _, part := range strings.Split(spec, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("structtag: invalid tag spec %q, want key=case", part)
+		}
+		convert, ok := strcase.CaseFunc(kv[1], acronym)
+		if !ok {
+			return nil, fmt.Errorf("structtag: unknown case %q of tag %s", kv[1], kv[0])
+		}
+		tags = append(tags, Tag{Key: kv[0], Convert: convert})
+	}
+	return tags, nil
+}
+
+// Source Processes Go source and returns formatted result
+func Source(filename string, src []byte, cfg Config) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	if err := File(file, cfg); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, file); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// File Adds and rewrites tags of struct fields in the file
+func File(file *ast.File, cfg Config) error {
+	var err error
+	ast.Inspect(file, func(n ast.Node) bool {
+		if err != nil {
+			return false
+		}
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		if cfg.Struct != "" && spec.Name.Name != cfg.Struct {
+			return true
+		}
+		ast.Inspect(spec.Type, func(n ast.Node) bool {
+			if st, ok := n.(*ast.StructType); ok && err == nil {
+				err = structTags(st, cfg)
+			}
+			return err == nil
+		})
+		return false
+	})
+	return err
+}
+
+func structTags(st *ast.StructType, cfg Config) error {
+	for _, field := range st.Fields.List {
+		if len(field.Names) != 1 || !field.Names[0].IsExported() {
+			continue
+		}
+		var tags []tagValue
+		if field.Tag != nil {
+			raw, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				return err
+			}
+			if tags, err = parseTag(raw); err != nil {
+				return fmt.Errorf("structtag: field %s: %v", field.Names[0].Name, err)
+			}
+		}
+
+		changed := false
+		for _, tag := range cfg.Tags {
+			name := tag.Convert(field.Names[0].Name)
+			i := findTag(tags, tag.Key)
+			if i < 0 {
+				tags = append(tags, tagValue{key: tag.Key, value: name})
+				changed = true
+				continue
+			}
+			current, opts := splitTagValue(tags[i].value)
+			if current == "-" || current == name || current != "" && !cfg.Overwrite {
+				continue
+			}
+			tags[i].value = name + opts
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+
+		raw := formatTag(tags)
+		value := "`" + raw + "`"
+		if strings.ContainsRune(raw, '`') {
+			value = strconv.Quote(raw)
+		}
+		if field.Tag == nil {
+			field.Tag = &ast.BasicLit{ValuePos: field.Type.End(), Kind: token.STRING}
+		}
+		field.Tag.Value = value
+	}
+	return nil
+}
+
+// SetName Sets name of the key in the struct tag, options of the key and other keys are preserved.
+// Ex. SetName(`json:"id,omitempty" db:"id"`, "json", "userID") -> `json:"userID,omitempty" db:"id"`
+func SetName(tag, key, name string) (string, error) {
+	tags, err := parseTag(tag)
+	if err != nil {
+		return "", err
+	}
+	if i := findTag(tags, key); i >= 0 {
+		_, opts := splitTagValue(tags[i].value)
+		tags[i].value = name + opts
+	} else {
+		tags = append(tags, tagValue{key: key, value: name})
+	}
+	return formatTag(tags), nil
+}
+
+// Name Name of the key in the struct tag without options, ok is false if the key is not present
+func Name(tag, key string) (name string, ok bool, err error) {
+	tags, err := parseTag(tag)
+	if err != nil {
+		return "", false, err
+	}
+	if i := findTag(tags, key); i >= 0 {
+		name, _ = splitTagValue(tags[i].value)
+		return name, true, nil
+	}
+	return "", false, nil
+}
+
+type tagValue struct {
+	key, value string
+}
+
+// parseTag Parses tag in reflect.StructTag conventional format preserving the order of keys
+func parseTag(tag string) ([]tagValue, error) {
+	var tags []tagValue
+	for {
+		tag = strings.TrimLeft(tag, " ")
+		if tag == "" {
+			return tags, nil
+		}
+		i := 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			return nil, fmt.Errorf("bad syntax of tag %q", tag)
+		}
+		key := tag[:i]
+		tag = tag[i+1:]
+
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(tag) {
+			return nil, fmt.Errorf("bad syntax of tag value %q", tag)
+		}
+		value, err := strconv.Unquote(tag[:i+1])
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tagValue{key: key, value: value})
+		tag = tag[i+1:]
+	}
+}
+
+func formatTag(tags []tagValue) string {
+	parts := make([]string, 0, len(tags))
+	for _, t := range tags {
+		parts = append(parts, t.key+":"+strconv.Quote(t.value))
+	}
+	return strings.Join(parts, " ")
+}
+
+func findTag(tags []tagValue, key string) int {
+	for i, t := range tags {
+		if t.key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// splitTagValue Splits tag value into name and options with leading comma
+func splitTagValue(value string) (string, string) {
+	if i := strings.IndexByte(value, ','); i >= 0 {
+		return value[:i], value[i:]
+	}
+	return value, ""
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package structtag
+
+import (
+	"testing"
+)
+
+func TestSource(t *testing.T) {
+	tags, err := ParseTags("json=camel,db=snake,yaml=kebab", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		cfg  Config
+		src  string
+		want string
+	}{
+		{
+			name: "add",
+			cfg:  Config{Tags: tags},
+			src: `package p
+
+type User struct {
+	UserID    int
+	FirstName string // first name
+	password  string
+	Embedded
+	A, B int
+}
+`,
+			want: `package p
+
+type User struct {
+	UserID    int    ` + "`" + `json:"userID" db:"user_ID" yaml:"user-ID"` + "`" + `
+	FirstName string ` + "`" + `json:"firstName" db:"first_name" yaml:"first-name"` + "`" + ` // first name
+	password  string
+	Embedded
+	A, B int
+}
+`,
+		},
+		{
+			name: "preserve options and explicit names",
+			cfg:  Config{Tags: tags},
+			src: `package p
+
+type User struct {
+	UserID    int    ` + "`" + `json:",omitempty" validate:"required"` + "`" + `
+	FirstName string ` + "`" + `db:"name" yaml:"-"` + "`" + `
+}
+`,
+			want: `package p
+
+type User struct {
+	UserID    int    ` + "`" + `json:"userID,omitempty" validate:"required" db:"user_ID" yaml:"user-ID"` + "`" + `
+	FirstName string ` + "`" + `db:"name" yaml:"-" json:"firstName"` + "`" + `
+}
+`,
+		},
+		{
+			name: "overwrite",
+			cfg:  Config{Tags: tags[1:2], Overwrite: true},
+			src: `package p
+
+type User struct {
+	FirstName string ` + "`" + `db:"name,pk"` + "`" + `
+}
+`,
+			want: `package p
+
+type User struct {
+	FirstName string ` + "`" + `db:"first_name,pk"` + "`" + `
+}
+`,
+		},
+		{
+			name: "struct filter and nested struct",
+			cfg:  Config{Tags: tags[:1], Struct: "Order"},
+			src: `package p
+
+type User struct {
+	FirstName string
+}
+
+type Order struct {
+	OrderItem struct {
+		ItemName string
+	}
+}
+`,
+			want: `package p
+
+type User struct {
+	FirstName string
+}
+
+type Order struct {
+	OrderItem struct {
+		ItemName string ` + "`" + `json:"itemName"` + "`" + `
+	} ` + "`" + `json:"orderItem"` + "`" + `
+}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Source("p.go", []byte(tt.src), tt.cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Source() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSourceBadTag(t *testing.T) {
+	tags, _ := ParseTags("json=camel", false)
+	_, err := Source("p.go", []byte("package p\n\ntype T struct {\n\tName string `json:name`\n}\n"), Config{Tags: tags})
+	if err == nil {
+		t.Errorf("Source() error = nil, want error")
+	}
+}
+
+func TestParseTags(t *testing.T) {
+	for _, spec := range []string{"json", "json=upper", "=camel"} {
+		if _, err := ParseTags(spec, false); err == nil {
+			t.Errorf("ParseTags(%q) error = nil, want error", spec)
+		}
+	}
+}
+
+func TestSetName(t *testing.T) {
+	tests := []struct {
+		tag  string
+		key  string
+		name string
+		want string
+	}{
+		{tag: `json:"id,omitempty" db:"id"`, key: "json", name: "userID", want: `json:"userID,omitempty" db:"id"`},
+		{tag: `db:"id"`, key: "json", name: "userID", want: `db:"id" json:"userID"`},
+		{tag: ``, key: "json", name: "userID", want: `json:"userID"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got, err := SetName(tt.tag, tt.key, tt.name)
+			if err != nil || got != tt.want {
+				t.Errorf("SetName() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestName(t *testing.T) {
+	name, ok, err := Name(`json:"userID,omitempty" db:"id"`, "json")
+	if name != "userID" || !ok || err != nil {
+		t.Errorf("Name() = %v, %v, %v, want userID, true, <nil>", name, ok, err)
+	}
+	if _, ok, _ := Name(`db:"id"`, "json"); ok {
+		t.Errorf("Name() ok = true, want false")
+	}
+}
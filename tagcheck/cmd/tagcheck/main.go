@@ -0,0 +1,33 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command tagcheck runs the tagcheck analyzer, standalone or as go vet -vettool.
+//
+// Usage:
+//
+//	tagcheck -tags json=camel,db=snake -acronym ./...
+//	go vet -vettool=$(which tagcheck) -tagcheck.tags json=camel ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/nikitaksv/strcase/tagcheck"
+)
+
+func main() {
+	singlechecker.Main(tagcheck.Analyzer)
+}
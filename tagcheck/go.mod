@@ -0,0 +1,16 @@
+module github.com/nikitaksv/strcase/tagcheck
+
+go 1.26.0
+
+require (
+	github.com/nikitaksv/strcase v0.0.0-00010101000000-000000000000
+	golang.org/x/tools v0.51.0
+)
+
+require (
+	golang.org/x/mod v0.41.0 // indirect
+	golang.org/x/sync v0.23.0 // indirect
+)
+
+// Until strcase has a release tag with the packages used here, build against the local tree.
+replace github.com/nikitaksv/strcase => ../
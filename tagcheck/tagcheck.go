@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package tagcheck defines an Analyzer that reports struct tag names
+// which do not match the strcase conversion of the field name.
+//
+// With -tags json=camel,db=snake the field
+//
+//	UserName string `json:"user_name" db:"user_name"`
+//
+// is reported because the json name should be "userName". Tags without the key,
+// tags with the "-" name and unexported fields are not checked.
+package tagcheck
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/nikitaksv/strcase/structtag"
+)
+
+const doc = `check that struct tag names match the case of field names
+
+The tagcheck analyzer reports struct tag names which differ from the strcase
+conversion of the field name, ex. json:"user_name" of the UserName field with
+-tags json=camel, and suggests the fix.`
+
+// Config Analyzer configuration
+type Config struct {
+	// Tags Tag keys and cases for all packages. Ex. "json=camel,db=snake"
+	Tags string
+	// PackageTags Tags by import path prefix, the longest prefix wins over Tags.
+	// Ex. "example.com/api=json=camel;example.com/store=db=snake"
+	PackageTags string
+	// Acronym Tag names use acronyms. Ex. json:"userID"
+	Acronym bool
+}
+
+// Analyzer Default analyzer, configured with flags -tags, -package-tags and -acronym
+var Analyzer = NewAnalyzer(Config{Tags: "json=camel"})
+
+// NewAnalyzer Analyzer with the configuration, fields of cfg are also bound to the analyzer flags
+func NewAnalyzer(cfg Config) *analysis.Analyzer {
+	c := &cfg
+	a := &analysis.Analyzer{
+		Name:     "tagcheck",
+		Doc:      doc,
+		Requires: []*analysis.Analyzer{inspect.Analyzer},
+		Run: func(pass *analysis.Pass) (interface{}, error) {
+			return nil, c.run(pass)
+		},
+	}
+	a.Flags.StringVar(&c.Tags, "tags", c.Tags, "tag keys and cases: key=case,...")
+	a.Flags.StringVar(&c.PackageTags, "package-tags", c.PackageTags, "tags by import path prefix: prefix=key=case,...;prefix=...")
+	a.Flags.BoolVar(&c.Acronym, "acronym", c.Acronym, "tag names use acronyms")
+	return a
+}
+
+// packageSpec Tags spec of the package
+func (c *Config) packageSpec(path string) (string, error) {
+	spec, best := c.Tags, -1
+	if c.PackageTags == "" {
+		return spec, nil
+	}
+	for _, rule := range strings.Split(c.PackageTags, ";") {
+		kv := strings.SplitN(strings.TrimSpace(rule), "=", 2)
+		if len(kv) != 2 {
+			return "", fmt.Errorf("tagcheck: invalid package tags %q, want prefix=key=case,...", rule)
+		}
+		prefix := kv[0]
+		if (path == prefix || strings.HasPrefix(path, prefix+"/")) && len(prefix) > best {
+			spec, best = kv[1], len(prefix)
+		}
+	}
+	return spec, nil
+}
+
+func (c *Config) run(pass *analysis.Pass) error {
+	spec, err := c.packageSpec(pass.Pkg.Path())
+	if err != nil || spec == "" {
+		return err
+	}
+	tags, err := structtag.ParseTags(spec, c.Acronym)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].Key < tags[j].Key })
+
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	insp.Preorder([]ast.Node{(*ast.Field)(nil)}, func(n ast.Node) {
+		field := n.(*ast.Field)
+		if field.Tag == nil || len(field.Names) != 1 || !field.Names[0].IsExported() {
+			return
+		}
+		raw, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			return
+		}
+		for _, tag := range tags {
+			name, ok, err := structtag.Name(raw, tag.Key)
+			if err != nil {
+				return
+			}
+			want := tag.Convert(field.Names[0].Name)
+			if !ok || name == "-" || name == want {
+				continue
+			}
+			pass.Report(analysis.Diagnostic{
+				Pos:     field.Tag.Pos(),
+				End:     field.Tag.End(),
+				Message: fmt.Sprintf("%s tag name %q of field %s should be %q", tag.Key, name, field.Names[0].Name, want),
+				SuggestedFixes: []analysis.SuggestedFix{{
+					Message:   fmt.Sprintf("Rename %s tag to %q", tag.Key, want),
+					TextEdits: []analysis.TextEdit{nameEdit(field.Tag, raw, tag.Key, want)},
+				}},
+			})
+		}
+	})
+	return nil
+}
+
+// nameEdit Replaces the name of the key in the tag literal.
+// Only the name is replaced in raw string literals, so fixes of several keys do not overlap.
+func nameEdit(lit *ast.BasicLit, raw, key, name string) analysis.TextEdit {
+	if strings.HasPrefix(lit.Value, "`") {
+		if start := keyValueOffset(raw, key); start >= 0 {
+			end := start + strings.IndexAny(raw[start:], `,"`)
+			pos := lit.Pos() + 1
+			return analysis.TextEdit{Pos: pos + token.Pos(start), End: pos + token.Pos(end), NewText: []byte(name)}
+		}
+	}
+	tag, _ := structtag.SetName(raw, key, name)
+	return analysis.TextEdit{Pos: lit.Pos(), End: lit.End(), NewText: []byte(strconv.Quote(tag))}
+}
+
+// keyValueOffset Offset of the value of the key in the raw tag
+func keyValueOffset(raw, key string) int {
+	prefix := key + `:"`
+	for i := 0; i < len(raw); {
+		j := strings.Index(raw[i:], prefix)
+		if j < 0 {
+			return -1
+		}
+		j += i
+		if j == 0 || raw[j-1] == ' ' {
+			return j + len(prefix)
+		}
+		i = j + 1
+	}
+	return -1
+}
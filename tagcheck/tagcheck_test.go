@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tagcheck
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	a := NewAnalyzer(Config{Tags: "json=camel,db=snake"})
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), a, "a")
+}
+
+func TestAnalyzerPackageTags(t *testing.T) {
+	a := NewAnalyzer(Config{Tags: "json=camel", PackageTags: "b/models=db=snake", Acronym: true})
+	analysistest.Run(t, analysistest.TestData(), a, "b", "b/models")
+}
+
+func TestAnalyzerFlags(t *testing.T) {
+	a := NewAnalyzer(Config{})
+	if err := a.Flags.Parse([]string{"-tags", "json=camel,db=snake"}); err != nil {
+		t.Fatal(err)
+	}
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), a, "a")
+}
+
+func TestPackageSpec(t *testing.T) {
+	c := Config{Tags: "json=camel", PackageTags: "example.com/api=json=snake;example.com/api/v2=json=kebab"}
+	tests := map[string]string{
+		"example.com/other":   "json=camel",
+		"example.com/api":     "json=snake",
+		"example.com/apiv2":   "json=camel",
+		"example.com/api/v1":  "json=snake",
+		"example.com/api/v2":  "json=kebab",
+		"example.com/api/v2/": "json=kebab",
+	}
+	for path, want := range tests {
+		if got, err := c.packageSpec(path); err != nil || got != want {
+			t.Errorf("packageSpec(%q) = %v, %v, want %v", path, got, err, want)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package a
+
+type User struct {
+	UserID    int    `json:"user_id,omitempty" db:"user_id"` // want `json tag name "user_id" of field UserID should be "userId"`
+	FirstName string `json:"firstName" db:"first_name"`
+	LastName  string `json:"last_name" db:"lastName"` // want `json tag name "last_name" of field LastName should be "lastName"` `db tag name "lastName" of field LastName should be "last_name"`
+	Email     string `json:",omitempty"`            // want `json tag name "" of field Email should be "email"`
+	Password  string `json:"-"`
+	Phone     string "json:\"phone_number\""          // want `json tag name "phone_number" of field Phone should be "phone"`
+	internal  string `json:"INTERNAL"`
+	Note      string
+}
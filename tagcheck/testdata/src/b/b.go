@@ -0,0 +1,5 @@
+package b
+
+type Order struct {
+	OrderID int `json:"order_id" db:"OrderID"` // want `json tag name "order_id" of field OrderID should be "orderID"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type Order struct {
+	OrderID int `json:"orderId" db:"OrderID"` // want `db tag name "OrderID" of field OrderID should be "order_ID"`
+}
@@ -0,0 +1,239 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+//go:generate go run ./internal/maketables
+
+import (
+	"fmt"
+	"unicode"
+)
+
+// transliterations ASCII replacements of lowercase runes without a canonical decomposition to ASCII
+var transliterations = map[rune]string{
+	// Latin
+	'ß': "ss",
+	'æ': "ae",
+	'ø': "o",
+	'œ': "oe",
+	'đ': "d",
+	'ð': "d",
+	'þ': "th",
+	'ł': "l",
+	'ı': "i",
+	'ħ': "h",
+	'ŋ': "ng",
+	'ŀ': "l",
+	'ſ': "s",
+	'ŧ': "t",
+	'ĳ': "ij",
+	'ƒ': "f",
+	// Latin digraphs and ligatures with a compatibility decomposition
+	'ǆ': "dz",
+	'ǳ': "dz",
+	'ǉ': "lj",
+	'ǌ': "nj",
+	'ﬀ': "ff",
+	'ﬁ': "fi",
+	'ﬂ': "fl",
+	'ﬃ': "ffi",
+	'ﬄ': "ffl",
+	'ﬅ': "st",
+	'ﬆ': "st",
+	// Cyrillic
+	'а': "a",
+	'б': "b",
+	'в': "v",
+	'г': "g",
+	'д': "d",
+	'е': "e",
+	'ж': "zh",
+	'з': "z",
+	'и': "i",
+	'к': "k",
+	'л': "l",
+	'м': "m",
+	'н': "n",
+	'о': "o",
+	'п': "p",
+	'р': "r",
+	'с': "s",
+	'т': "t",
+	'у': "u",
+	'ф': "f",
+	'х': "kh",
+	'ц': "ts",
+	'ч': "ch",
+	'ш': "sh",
+	'щ': "shch",
+	'ъ': "",
+	'ы': "y",
+	'ь': "",
+	'э': "e",
+	'ю': "yu",
+	'я': "ya",
+	'є': "ye",
+	'і': "i",
+	'ґ': "g",
+	'ђ': "dj",
+	'ј': "j",
+	'љ': "lj",
+	'њ': "nj",
+	'ћ': "c",
+	'џ': "dz",
+	'ѕ': "dz",
+	// Greek
+	'α': "a",
+	'β': "v",
+	'γ': "g",
+	'δ': "d",
+	'ε': "e",
+	'ζ': "z",
+	'η': "i",
+	'θ': "th",
+	'ι': "i",
+	'κ': "k",
+	'λ': "l",
+	'μ': "m",
+	'ν': "n",
+	'ξ': "x",
+	'ο': "o",
+	'π': "p",
+	'ρ': "r",
+	'σ': "s",
+	'ς': "s",
+	'τ': "t",
+	'υ': "y",
+	'φ': "f",
+	'χ': "ch",
+	'ψ': "ps",
+	'ω': "o",
+}
+
+// decompositionExceptions Precomposed runes with a conventional transliteration
+// that differs from their base rune
+var decompositionExceptions = map[rune]string{
+	'ё': "yo",
+	'й': "y",
+	'ї': "yi",
+	'ў': "u",
+	'ѓ': "gj",
+	'ќ': "kj",
+}
+
+// TransliterationError Rune which has no ASCII transliteration
+type TransliterationError struct {
+	Rune  rune
+	Index int
+}
+
+func (e *TransliterationError) Error() string {
+	return fmt.Sprintf("strcase: cannot transliterate %q at index %d", e.Rune, e.Index)
+}
+
+// Transliterate Folds string to ASCII. Ex. "Número de pedido" -> "Numero de pedido"
+// Runes without transliteration are removed.
+func Transliterate(str string) string {
+	return string(TransliterateRunes([]rune(str)))
+}
+
+// TransliterateStrict Folds string to ASCII.
+// Returns *TransliterationError for the first rune without transliteration.
+func TransliterateStrict(str string) (string, error) {
+	rs, err := TransliterateStrictRunes([]rune(str))
+	if err != nil {
+		return "", err
+	}
+	return string(rs), nil
+}
+
+// TransliterateRunes Folds slice of runes to ASCII. Ex. "Número de pedido" -> "Numero de pedido"
+// Runes without transliteration are removed.
+func TransliterateRunes(runes []rune) []rune {
+	rs, _ := transliterateRunes(runes, false)
+	return rs
+}
+
+// TransliterateStrictRunes Folds slice of runes to ASCII.
+// Returns *TransliterationError for the first rune without transliteration.
+func TransliterateStrictRunes(runes []rune) ([]rune, error) {
+	return transliterateRunes(runes, true)
+}
+
+func transliterateRunes(runes []rune, strict bool) ([]rune, error) {
+	out := make([]rune, 0, len(runes))
+	for i, r := range runes {
+		if r <= unicode.MaxASCII {
+			out = append(out, r)
+			continue
+		}
+
+		lower := unicode.ToLower(r)
+		t, ok := decompositionExceptions[lower]
+		if !ok {
+			if base, found := decompositionBase[r]; found {
+				if base <= unicode.MaxASCII {
+					out = append(out, base)
+					continue
+				}
+				r, lower = base, unicode.ToLower(base)
+			}
+			t, ok = transliterations[lower]
+		}
+		if ok {
+			switch {
+			case unicode.IsTitle(r):
+				t = string(toTitleRunes([]rune(t)))
+			case r != lower:
+				t = upperTransliteration(t, runes, i)
+			}
+			out = append(out, []rune(t)...)
+			continue
+		}
+
+		switch {
+		case unicode.Is(unicode.Mn, r):
+		case unicode.IsSpace(r):
+			out = append(out, ' ')
+		case unicode.Is(unicode.Pd, r):
+			out = append(out, SeparatorDash)
+		case strict:
+			return nil, &TransliterationError{Rune: runes[i], Index: i}
+		}
+	}
+	return out, nil
+}
+
+// upperTransliteration Uppercase rune is transliterated in upper case inside an uppercase word
+// and capitalized otherwise. Ex. "ЩИ" -> "SHCHI", "Щи" -> "Shchi"
+func upperTransliteration(t string, runes []rune, i int) string {
+	if t == "" {
+		return t
+	}
+	if (i+1 < len(runes) && unicode.IsUpper(runes[i+1])) ||
+		(i > 0 && unicode.IsUpper(runes[i-1]) && (i+1 == len(runes) || !unicode.IsLetter(runes[i+1]))) {
+		return string(toUpperRunes([]rune(t)))
+	}
+	return string(toTitleRunes([]rune(t)))
+}
+
+func toUpperRunes(runes []rune) []rune {
+	for i, r := range runes {
+		runes[i] = unicode.ToUpper(r)
+	}
+	return runes
+}
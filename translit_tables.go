@@ -0,0 +1,811 @@
+// Code generated by internal/maketables; DO NOT EDIT.
+
+package strcase
+
+// decompositionBase Base rune of the full canonical decomposition of precomposed runes.
+var decompositionBase = map[rune]rune{
+	0x00C0: 0x0041, // À -> A
+	0x00C1: 0x0041, // Á -> A
+	0x00C2: 0x0041, // Â -> A
+	0x00C3: 0x0041, // Ã -> A
+	0x00C4: 0x0041, // Ä -> A
+	0x00C5: 0x0041, // Å -> A
+	0x00C7: 0x0043, // Ç -> C
+	0x00C8: 0x0045, // È -> E
+	0x00C9: 0x0045, // É -> E
+	0x00CA: 0x0045, // Ê -> E
+	0x00CB: 0x0045, // Ë -> E
+	0x00CC: 0x0049, // Ì -> I
+	0x00CD: 0x0049, // Í -> I
+	0x00CE: 0x0049, // Î -> I
+	0x00CF: 0x0049, // Ï -> I
+	0x00D1: 0x004E, // Ñ -> N
+	0x00D2: 0x004F, // Ò -> O
+	0x00D3: 0x004F, // Ó -> O
+	0x00D4: 0x004F, // Ô -> O
+	0x00D5: 0x004F, // Õ -> O
+	0x00D6: 0x004F, // Ö -> O
+	0x00D9: 0x0055, // Ù -> U
+	0x00DA: 0x0055, // Ú -> U
+	0x00DB: 0x0055, // Û -> U
+	0x00DC: 0x0055, // Ü -> U
+	0x00DD: 0x0059, // Ý -> Y
+	0x00E0: 0x0061, // à -> a
+	0x00E1: 0x0061, // á -> a
+	0x00E2: 0x0061, // â -> a
+	0x00E3: 0x0061, // ã -> a
+	0x00E4: 0x0061, // ä -> a
+	0x00E5: 0x0061, // å -> a
+	0x00E7: 0x0063, // ç -> c
+	0x00E8: 0x0065, // è -> e
+	0x00E9: 0x0065, // é -> e
+	0x00EA: 0x0065, // ê -> e
+	0x00EB: 0x0065, // ë -> e
+	0x00EC: 0x0069, // ì -> i
+	0x00ED: 0x0069, // í -> i
+	0x00EE: 0x0069, // î -> i
+	0x00EF: 0x0069, // ï -> i
+	0x00F1: 0x006E, // ñ -> n
+	0x00F2: 0x006F, // ò -> o
+	0x00F3: 0x006F, // ó -> o
+	0x00F4: 0x006F, // ô -> o
+	0x00F5: 0x006F, // õ -> o
+	0x00F6: 0x006F, // ö -> o
+	0x00F9: 0x0075, // ù -> u
+	0x00FA: 0x0075, // ú -> u
+	0x00FB: 0x0075, // û -> u
+	0x00FC: 0x0075, // ü -> u
+	0x00FD: 0x0079, // ý -> y
+	0x00FF: 0x0079, // ÿ -> y
+	0x0100: 0x0041, // Ā -> A
+	0x0101: 0x0061, // ā -> a
+	0x0102: 0x0041, // Ă -> A
+	0x0103: 0x0061, // ă -> a
+	0x0104: 0x0041, // Ą -> A
+	0x0105: 0x0061, // ą -> a
+	0x0106: 0x0043, // Ć -> C
+	0x0107: 0x0063, // ć -> c
+	0x0108: 0x0043, // Ĉ -> C
+	0x0109: 0x0063, // ĉ -> c
+	0x010A: 0x0043, // Ċ -> C
+	0x010B: 0x0063, // ċ -> c
+	0x010C: 0x0043, // Č -> C
+	0x010D: 0x0063, // č -> c
+	0x010E: 0x0044, // Ď -> D
+	0x010F: 0x0064, // ď -> d
+	0x0112: 0x0045, // Ē -> E
+	0x0113: 0x0065, // ē -> e
+	0x0114: 0x0045, // Ĕ -> E
+	0x0115: 0x0065, // ĕ -> e
+	0x0116: 0x0045, // Ė -> E
+	0x0117: 0x0065, // ė -> e
+	0x0118: 0x0045, // Ę -> E
+	0x0119: 0x0065, // ę -> e
+	0x011A: 0x0045, // Ě -> E
+	0x011B: 0x0065, // ě -> e
+	0x011C: 0x0047, // Ĝ -> G
+	0x011D: 0x0067, // ĝ -> g
+	0x011E: 0x0047, // Ğ -> G
+	0x011F: 0x0067, // ğ -> g
+	0x0120: 0x0047, // Ġ -> G
+	0x0121: 0x0067, // ġ -> g
+	0x0122: 0x0047, // Ģ -> G
+	0x0123: 0x0067, // ģ -> g
+	0x0124: 0x0048, // Ĥ -> H
+	0x0125: 0x0068, // ĥ -> h
+	0x0128: 0x0049, // Ĩ -> I
+	0x0129: 0x0069, // ĩ -> i
+	0x012A: 0x0049, // Ī -> I
+	0x012B: 0x0069, // ī -> i
+	0x012C: 0x0049, // Ĭ -> I
+	0x012D: 0x0069, // ĭ -> i
+	0x012E: 0x0049, // Į -> I
+	0x012F: 0x0069, // į -> i
+	0x0130: 0x0049, // İ -> I
+	0x0134: 0x004A, // Ĵ -> J
+	0x0135: 0x006A, // ĵ -> j
+	0x0136: 0x004B, // Ķ -> K
+	0x0137: 0x006B, // ķ -> k
+	0x0139: 0x004C, // Ĺ -> L
+	0x013A: 0x006C, // ĺ -> l
+	0x013B: 0x004C, // Ļ -> L
+	0x013C: 0x006C, // ļ -> l
+	0x013D: 0x004C, // Ľ -> L
+	0x013E: 0x006C, // ľ -> l
+	0x0143: 0x004E, // Ń -> N
+	0x0144: 0x006E, // ń -> n
+	0x0145: 0x004E, // Ņ -> N
+	0x0146: 0x006E, // ņ -> n
+	0x0147: 0x004E, // Ň -> N
+	0x0148: 0x006E, // ň -> n
+	0x014C: 0x004F, // Ō -> O
+	0x014D: 0x006F, // ō -> o
+	0x014E: 0x004F, // Ŏ -> O
+	0x014F: 0x006F, // ŏ -> o
+	0x0150: 0x004F, // Ő -> O
+	0x0151: 0x006F, // ő -> o
+	0x0154: 0x0052, // Ŕ -> R
+	0x0155: 0x0072, // ŕ -> r
+	0x0156: 0x0052, // Ŗ -> R
+	0x0157: 0x0072, // ŗ -> r
+	0x0158: 0x0052, // Ř -> R
+	0x0159: 0x0072, // ř -> r
+	0x015A: 0x0053, // Ś -> S
+	0x015B: 0x0073, // ś -> s
+	0x015C: 0x0053, // Ŝ -> S
+	0x015D: 0x0073, // ŝ -> s
+	0x015E: 0x0053, // Ş -> S
+	0x015F: 0x0073, // ş -> s
+	0x0160: 0x0053, // Š -> S
+	0x0161: 0x0073, // š -> s
+	0x0162: 0x0054, // Ţ -> T
+	0x0163: 0x0074, // ţ -> t
+	0x0164: 0x0054, // Ť -> T
+	0x0165: 0x0074, // ť -> t
+	0x0168: 0x0055, // Ũ -> U
+	0x0169: 0x0075, // ũ -> u
+	0x016A: 0x0055, // Ū -> U
+	0x016B: 0x0075, // ū -> u
+	0x016C: 0x0055, // Ŭ -> U
+	0x016D: 0x0075, // ŭ -> u
+	0x016E: 0x0055, // Ů -> U
+	0x016F: 0x0075, // ů -> u
+	0x0170: 0x0055, // Ű -> U
+	0x0171: 0x0075, // ű -> u
+	0x0172: 0x0055, // Ų -> U
+	0x0173: 0x0075, // ų -> u
+	0x0174: 0x0057, // Ŵ -> W
+	0x0175: 0x0077, // ŵ -> w
+	0x0176: 0x0059, // Ŷ -> Y
+	0x0177: 0x0079, // ŷ -> y
+	0x0178: 0x0059, // Ÿ -> Y
+	0x0179: 0x005A, // Ź -> Z
+	0x017A: 0x007A, // ź -> z
+	0x017B: 0x005A, // Ż -> Z
+	0x017C: 0x007A, // ż -> z
+	0x017D: 0x005A, // Ž -> Z
+	0x017E: 0x007A, // ž -> z
+	0x01A0: 0x004F, // Ơ -> O
+	0x01A1: 0x006F, // ơ -> o
+	0x01AF: 0x0055, // Ư -> U
+	0x01B0: 0x0075, // ư -> u
+	0x01CD: 0x0041, // Ǎ -> A
+	0x01CE: 0x0061, // ǎ -> a
+	0x01CF: 0x0049, // Ǐ -> I
+	0x01D0: 0x0069, // ǐ -> i
+	0x01D1: 0x004F, // Ǒ -> O
+	0x01D2: 0x006F, // ǒ -> o
+	0x01D3: 0x0055, // Ǔ -> U
+	0x01D4: 0x0075, // ǔ -> u
+	0x01D5: 0x0055, // Ǖ -> U
+	0x01D6: 0x0075, // ǖ -> u
+	0x01D7: 0x0055, // Ǘ -> U
+	0x01D8: 0x0075, // ǘ -> u
+	0x01D9: 0x0055, // Ǚ -> U
+	0x01DA: 0x0075, // ǚ -> u
+	0x01DB: 0x0055, // Ǜ -> U
+	0x01DC: 0x0075, // ǜ -> u
+	0x01DE: 0x0041, // Ǟ -> A
+	0x01DF: 0x0061, // ǟ -> a
+	0x01E0: 0x0041, // Ǡ -> A
+	0x01E1: 0x0061, // ǡ -> a
+	0x01E2: 0x00C6, // Ǣ -> Æ
+	0x01E3: 0x00E6, // ǣ -> æ
+	0x01E6: 0x0047, // Ǧ -> G
+	0x01E7: 0x0067, // ǧ -> g
+	0x01E8: 0x004B, // Ǩ -> K
+	0x01E9: 0x006B, // ǩ -> k
+	0x01EA: 0x004F, // Ǫ -> O
+	0x01EB: 0x006F, // ǫ -> o
+	0x01EC: 0x004F, // Ǭ -> O
+	0x01ED: 0x006F, // ǭ -> o
+	0x01EE: 0x01B7, // Ǯ -> Ʒ
+	0x01EF: 0x0292, // ǯ -> ʒ
+	0x01F0: 0x006A, // ǰ -> j
+	0x01F4: 0x0047, // Ǵ -> G
+	0x01F5: 0x0067, // ǵ -> g
+	0x01F8: 0x004E, // Ǹ -> N
+	0x01F9: 0x006E, // ǹ -> n
+	0x01FA: 0x0041, // Ǻ -> A
+	0x01FB: 0x0061, // ǻ -> a
+	0x01FC: 0x00C6, // Ǽ -> Æ
+	0x01FD: 0x00E6, // ǽ -> æ
+	0x01FE: 0x00D8, // Ǿ -> Ø
+	0x01FF: 0x00F8, // ǿ -> ø
+	0x0200: 0x0041, // Ȁ -> A
+	0x0201: 0x0061, // ȁ -> a
+	0x0202: 0x0041, // Ȃ -> A
+	0x0203: 0x0061, // ȃ -> a
+	0x0204: 0x0045, // Ȅ -> E
+	0x0205: 0x0065, // ȅ -> e
+	0x0206: 0x0045, // Ȇ -> E
+	0x0207: 0x0065, // ȇ -> e
+	0x0208: 0x0049, // Ȉ -> I
+	0x0209: 0x0069, // ȉ -> i
+	0x020A: 0x0049, // Ȋ -> I
+	0x020B: 0x0069, // ȋ -> i
+	0x020C: 0x004F, // Ȍ -> O
+	0x020D: 0x006F, // ȍ -> o
+	0x020E: 0x004F, // Ȏ -> O
+	0x020F: 0x006F, // ȏ -> o
+	0x0210: 0x0052, // Ȑ -> R
+	0x0211: 0x0072, // ȑ -> r
+	0x0212: 0x0052, // Ȓ -> R
+	0x0213: 0x0072, // ȓ -> r
+	0x0214: 0x0055, // Ȕ -> U
+	0x0215: 0x0075, // ȕ -> u
+	0x0216: 0x0055, // Ȗ -> U
+	0x0217: 0x0075, // ȗ -> u
+	0x0218: 0x0053, // Ș -> S
+	0x0219: 0x0073, // ș -> s
+	0x021A: 0x0054, // Ț -> T
+	0x021B: 0x0074, // ț -> t
+	0x021E: 0x0048, // Ȟ -> H
+	0x021F: 0x0068, // ȟ -> h
+	0x0226: 0x0041, // Ȧ -> A
+	0x0227: 0x0061, // ȧ -> a
+	0x0228: 0x0045, // Ȩ -> E
+	0x0229: 0x0065, // ȩ -> e
+	0x022A: 0x004F, // Ȫ -> O
+	0x022B: 0x006F, // ȫ -> o
+	0x022C: 0x004F, // Ȭ -> O
+	0x022D: 0x006F, // ȭ -> o
+	0x022E: 0x004F, // Ȯ -> O
+	0x022F: 0x006F, // ȯ -> o
+	0x0230: 0x004F, // Ȱ -> O
+	0x0231: 0x006F, // ȱ -> o
+	0x0232: 0x0059, // Ȳ -> Y
+	0x0233: 0x0079, // ȳ -> y
+	0x0374: 0x02B9, // ʹ -> ʹ
+	0x037E: 0x003B, // ; -> ;
+	0x0385: 0x00A8, // ΅ -> ¨
+	0x0386: 0x0391, // Ά -> Α
+	0x0387: 0x00B7, // · -> ·
+	0x0388: 0x0395, // Έ -> Ε
+	0x0389: 0x0397, // Ή -> Η
+	0x038A: 0x0399, // Ί -> Ι
+	0x038C: 0x039F, // Ό -> Ο
+	0x038E: 0x03A5, // Ύ -> Υ
+	0x038F: 0x03A9, // Ώ -> Ω
+	0x0390: 0x03B9, // ΐ -> ι
+	0x03AA: 0x0399, // Ϊ -> Ι
+	0x03AB: 0x03A5, // Ϋ -> Υ
+	0x03AC: 0x03B1, // ά -> α
+	0x03AD: 0x03B5, // έ -> ε
+	0x03AE: 0x03B7, // ή -> η
+	0x03AF: 0x03B9, // ί -> ι
+	0x03B0: 0x03C5, // ΰ -> υ
+	0x03CA: 0x03B9, // ϊ -> ι
+	0x03CB: 0x03C5, // ϋ -> υ
+	0x03CC: 0x03BF, // ό -> ο
+	0x03CD: 0x03C5, // ύ -> υ
+	0x03CE: 0x03C9, // ώ -> ω
+	0x03D3: 0x03D2, // ϓ -> ϒ
+	0x03D4: 0x03D2, // ϔ -> ϒ
+	0x0400: 0x0415, // Ѐ -> Е
+	0x0401: 0x0415, // Ё -> Е
+	0x0403: 0x0413, // Ѓ -> Г
+	0x0407: 0x0406, // Ї -> І
+	0x040C: 0x041A, // Ќ -> К
+	0x040D: 0x0418, // Ѝ -> И
+	0x040E: 0x0423, // Ў -> У
+	0x0419: 0x0418, // Й -> И
+	0x0439: 0x0438, // й -> и
+	0x0450: 0x0435, // ѐ -> е
+	0x0451: 0x0435, // ё -> е
+	0x0453: 0x0433, // ѓ -> г
+	0x0457: 0x0456, // ї -> і
+	0x045C: 0x043A, // ќ -> к
+	0x045D: 0x0438, // ѝ -> и
+	0x045E: 0x0443, // ў -> у
+	0x0476: 0x0474, // Ѷ -> Ѵ
+	0x0477: 0x0475, // ѷ -> ѵ
+	0x04C1: 0x0416, // Ӂ -> Ж
+	0x04C2: 0x0436, // ӂ -> ж
+	0x04D0: 0x0410, // Ӑ -> А
+	0x04D1: 0x0430, // ӑ -> а
+	0x04D2: 0x0410, // Ӓ -> А
+	0x04D3: 0x0430, // ӓ -> а
+	0x04D6: 0x0415, // Ӗ -> Е
+	0x04D7: 0x0435, // ӗ -> е
+	0x04DA: 0x04D8, // Ӛ -> Ә
+	0x04DB: 0x04D9, // ӛ -> ә
+	0x04DC: 0x0416, // Ӝ -> Ж
+	0x04DD: 0x0436, // ӝ -> ж
+	0x04DE: 0x0417, // Ӟ -> З
+	0x04DF: 0x0437, // ӟ -> з
+	0x04E2: 0x0418, // Ӣ -> И
+	0x04E3: 0x0438, // ӣ -> и
+	0x04E4: 0x0418, // Ӥ -> И
+	0x04E5: 0x0438, // ӥ -> и
+	0x04E6: 0x041E, // Ӧ -> О
+	0x04E7: 0x043E, // ӧ -> о
+	0x04EA: 0x04E8, // Ӫ -> Ө
+	0x04EB: 0x04E9, // ӫ -> ө
+	0x04EC: 0x042D, // Ӭ -> Э
+	0x04ED: 0x044D, // ӭ -> э
+	0x04EE: 0x0423, // Ӯ -> У
+	0x04EF: 0x0443, // ӯ -> у
+	0x04F0: 0x0423, // Ӱ -> У
+	0x04F1: 0x0443, // ӱ -> у
+	0x04F2: 0x0423, // Ӳ -> У
+	0x04F3: 0x0443, // ӳ -> у
+	0x04F4: 0x0427, // Ӵ -> Ч
+	0x04F5: 0x0447, // ӵ -> ч
+	0x04F8: 0x042B, // Ӹ -> Ы
+	0x04F9: 0x044B, // ӹ -> ы
+	0x1E00: 0x0041, // Ḁ -> A
+	0x1E01: 0x0061, // ḁ -> a
+	0x1E02: 0x0042, // Ḃ -> B
+	0x1E03: 0x0062, // ḃ -> b
+	0x1E04: 0x0042, // Ḅ -> B
+	0x1E05: 0x0062, // ḅ -> b
+	0x1E06: 0x0042, // Ḇ -> B
+	0x1E07: 0x0062, // ḇ -> b
+	0x1E08: 0x0043, // Ḉ -> C
+	0x1E09: 0x0063, // ḉ -> c
+	0x1E0A: 0x0044, // Ḋ -> D
+	0x1E0B: 0x0064, // ḋ -> d
+	0x1E0C: 0x0044, // Ḍ -> D
+	0x1E0D: 0x0064, // ḍ -> d
+	0x1E0E: 0x0044, // Ḏ -> D
+	0x1E0F: 0x0064, // ḏ -> d
+	0x1E10: 0x0044, // Ḑ -> D
+	0x1E11: 0x0064, // ḑ -> d
+	0x1E12: 0x0044, // Ḓ -> D
+	0x1E13: 0x0064, // ḓ -> d
+	0x1E14: 0x0045, // Ḕ -> E
+	0x1E15: 0x0065, // ḕ -> e
+	0x1E16: 0x0045, // Ḗ -> E
+	0x1E17: 0x0065, // ḗ -> e
+	0x1E18: 0x0045, // Ḙ -> E
+	0x1E19: 0x0065, // ḙ -> e
+	0x1E1A: 0x0045, // Ḛ -> E
+	0x1E1B: 0x0065, // ḛ -> e
+	0x1E1C: 0x0045, // Ḝ -> E
+	0x1E1D: 0x0065, // ḝ -> e
+	0x1E1E: 0x0046, // Ḟ -> F
+	0x1E1F: 0x0066, // ḟ -> f
+	0x1E20: 0x0047, // Ḡ -> G
+	0x1E21: 0x0067, // ḡ -> g
+	0x1E22: 0x0048, // Ḣ -> H
+	0x1E23: 0x0068, // ḣ -> h
+	0x1E24: 0x0048, // Ḥ -> H
+	0x1E25: 0x0068, // ḥ -> h
+	0x1E26: 0x0048, // Ḧ -> H
+	0x1E27: 0x0068, // ḧ -> h
+	0x1E28: 0x0048, // Ḩ -> H
+	0x1E29: 0x0068, // ḩ -> h
+	0x1E2A: 0x0048, // Ḫ -> H
+	0x1E2B: 0x0068, // ḫ -> h
+	0x1E2C: 0x0049, // Ḭ -> I
+	0x1E2D: 0x0069, // ḭ -> i
+	0x1E2E: 0x0049, // Ḯ -> I
+	0x1E2F: 0x0069, // ḯ -> i
+	0x1E30: 0x004B, // Ḱ -> K
+	0x1E31: 0x006B, // ḱ -> k
+	0x1E32: 0x004B, // Ḳ -> K
+	0x1E33: 0x006B, // ḳ -> k
+	0x1E34: 0x004B, // Ḵ -> K
+	0x1E35: 0x006B, // ḵ -> k
+	0x1E36: 0x004C, // Ḷ -> L
+	0x1E37: 0x006C, // ḷ -> l
+	0x1E38: 0x004C, // Ḹ -> L
+	0x1E39: 0x006C, // ḹ -> l
+	0x1E3A: 0x004C, // Ḻ -> L
+	0x1E3B: 0x006C, // ḻ -> l
+	0x1E3C: 0x004C, // Ḽ -> L
+	0x1E3D: 0x006C, // ḽ -> l
+	0x1E3E: 0x004D, // Ḿ -> M
+	0x1E3F: 0x006D, // ḿ -> m
+	0x1E40: 0x004D, // Ṁ -> M
+	0x1E41: 0x006D, // ṁ -> m
+	0x1E42: 0x004D, // Ṃ -> M
+	0x1E43: 0x006D, // ṃ -> m
+	0x1E44: 0x004E, // Ṅ -> N
+	0x1E45: 0x006E, // ṅ -> n
+	0x1E46: 0x004E, // Ṇ -> N
+	0x1E47: 0x006E, // ṇ -> n
+	0x1E48: 0x004E, // Ṉ -> N
+	0x1E49: 0x006E, // ṉ -> n
+	0x1E4A: 0x004E, // Ṋ -> N
+	0x1E4B: 0x006E, // ṋ -> n
+	0x1E4C: 0x004F, // Ṍ -> O
+	0x1E4D: 0x006F, // ṍ -> o
+	0x1E4E: 0x004F, // Ṏ -> O
+	0x1E4F: 0x006F, // ṏ -> o
+	0x1E50: 0x004F, // Ṑ -> O
+	0x1E51: 0x006F, // ṑ -> o
+	0x1E52: 0x004F, // Ṓ -> O
+	0x1E53: 0x006F, // ṓ -> o
+	0x1E54: 0x0050, // Ṕ -> P
+	0x1E55: 0x0070, // ṕ -> p
+	0x1E56: 0x0050, // Ṗ -> P
+	0x1E57: 0x0070, // ṗ -> p
+	0x1E58: 0x0052, // Ṙ -> R
+	0x1E59: 0x0072, // ṙ -> r
+	0x1E5A: 0x0052, // Ṛ -> R
+	0x1E5B: 0x0072, // ṛ -> r
+	0x1E5C: 0x0052, // Ṝ -> R
+	0x1E5D: 0x0072, // ṝ -> r
+	0x1E5E: 0x0052, // Ṟ -> R
+	0x1E5F: 0x0072, // ṟ -> r
+	0x1E60: 0x0053, // Ṡ -> S
+	0x1E61: 0x0073, // ṡ -> s
+	0x1E62: 0x0053, // Ṣ -> S
+	0x1E63: 0x0073, // ṣ -> s
+	0x1E64: 0x0053, // Ṥ -> S
+	0x1E65: 0x0073, // ṥ -> s
+	0x1E66: 0x0053, // Ṧ -> S
+	0x1E67: 0x0073, // ṧ -> s
+	0x1E68: 0x0053, // Ṩ -> S
+	0x1E69: 0x0073, // ṩ -> s
+	0x1E6A: 0x0054, // Ṫ -> T
+	0x1E6B: 0x0074, // ṫ -> t
+	0x1E6C: 0x0054, // Ṭ -> T
+	0x1E6D: 0x0074, // ṭ -> t
+	0x1E6E: 0x0054, // Ṯ -> T
+	0x1E6F: 0x0074, // ṯ -> t
+	0x1E70: 0x0054, // Ṱ -> T
+	0x1E71: 0x0074, // ṱ -> t
+	0x1E72: 0x0055, // Ṳ -> U
+	0x1E73: 0x0075, // ṳ -> u
+	0x1E74: 0x0055, // Ṵ -> U
+	0x1E75: 0x0075, // ṵ -> u
+	0x1E76: 0x0055, // Ṷ -> U
+	0x1E77: 0x0075, // ṷ -> u
+	0x1E78: 0x0055, // Ṹ -> U
+	0x1E79: 0x0075, // ṹ -> u
+	0x1E7A: 0x0055, // Ṻ -> U
+	0x1E7B: 0x0075, // ṻ -> u
+	0x1E7C: 0x0056, // Ṽ -> V
+	0x1E7D: 0x0076, // ṽ -> v
+	0x1E7E: 0x0056, // Ṿ -> V
+	0x1E7F: 0x0076, // ṿ -> v
+	0x1E80: 0x0057, // Ẁ -> W
+	0x1E81: 0x0077, // ẁ -> w
+	0x1E82: 0x0057, // Ẃ -> W
+	0x1E83: 0x0077, // ẃ -> w
+	0x1E84: 0x0057, // Ẅ -> W
+	0x1E85: 0x0077, // ẅ -> w
+	0x1E86: 0x0057, // Ẇ -> W
+	0x1E87: 0x0077, // ẇ -> w
+	0x1E88: 0x0057, // Ẉ -> W
+	0x1E89: 0x0077, // ẉ -> w
+	0x1E8A: 0x0058, // Ẋ -> X
+	0x1E8B: 0x0078, // ẋ -> x
+	0x1E8C: 0x0058, // Ẍ -> X
+	0x1E8D: 0x0078, // ẍ -> x
+	0x1E8E: 0x0059, // Ẏ -> Y
+	0x1E8F: 0x0079, // ẏ -> y
+	0x1E90: 0x005A, // Ẑ -> Z
+	0x1E91: 0x007A, // ẑ -> z
+	0x1E92: 0x005A, // Ẓ -> Z
+	0x1E93: 0x007A, // ẓ -> z
+	0x1E94: 0x005A, // Ẕ -> Z
+	0x1E95: 0x007A, // ẕ -> z
+	0x1E96: 0x0068, // ẖ -> h
+	0x1E97: 0x0074, // ẗ -> t
+	0x1E98: 0x0077, // ẘ -> w
+	0x1E99: 0x0079, // ẙ -> y
+	0x1E9B: 0x017F, // ẛ -> ſ
+	0x1EA0: 0x0041, // Ạ -> A
+	0x1EA1: 0x0061, // ạ -> a
+	0x1EA2: 0x0041, // Ả -> A
+	0x1EA3: 0x0061, // ả -> a
+	0x1EA4: 0x0041, // Ấ -> A
+	0x1EA5: 0x0061, // ấ -> a
+	0x1EA6: 0x0041, // Ầ -> A
+	0x1EA7: 0x0061, // ầ -> a
+	0x1EA8: 0x0041, // Ẩ -> A
+	0x1EA9: 0x0061, // ẩ -> a
+	0x1EAA: 0x0041, // Ẫ -> A
+	0x1EAB: 0x0061, // ẫ -> a
+	0x1EAC: 0x0041, // Ậ -> A
+	0x1EAD: 0x0061, // ậ -> a
+	0x1EAE: 0x0041, // Ắ -> A
+	0x1EAF: 0x0061, // ắ -> a
+	0x1EB0: 0x0041, // Ằ -> A
+	0x1EB1: 0x0061, // ằ -> a
+	0x1EB2: 0x0041, // Ẳ -> A
+	0x1EB3: 0x0061, // ẳ -> a
+	0x1EB4: 0x0041, // Ẵ -> A
+	0x1EB5: 0x0061, // ẵ -> a
+	0x1EB6: 0x0041, // Ặ -> A
+	0x1EB7: 0x0061, // ặ -> a
+	0x1EB8: 0x0045, // Ẹ -> E
+	0x1EB9: 0x0065, // ẹ -> e
+	0x1EBA: 0x0045, // Ẻ -> E
+	0x1EBB: 0x0065, // ẻ -> e
+	0x1EBC: 0x0045, // Ẽ -> E
+	0x1EBD: 0x0065, // ẽ -> e
+	0x1EBE: 0x0045, // Ế -> E
+	0x1EBF: 0x0065, // ế -> e
+	0x1EC0: 0x0045, // Ề -> E
+	0x1EC1: 0x0065, // ề -> e
+	0x1EC2: 0x0045, // Ể -> E
+	0x1EC3: 0x0065, // ể -> e
+	0x1EC4: 0x0045, // Ễ -> E
+	0x1EC5: 0x0065, // ễ -> e
+	0x1EC6: 0x0045, // Ệ -> E
+	0x1EC7: 0x0065, // ệ -> e
+	0x1EC8: 0x0049, // Ỉ -> I
+	0x1EC9: 0x0069, // ỉ -> i
+	0x1ECA: 0x0049, // Ị -> I
+	0x1ECB: 0x0069, // ị -> i
+	0x1ECC: 0x004F, // Ọ -> O
+	0x1ECD: 0x006F, // ọ -> o
+	0x1ECE: 0x004F, // Ỏ -> O
+	0x1ECF: 0x006F, // ỏ -> o
+	0x1ED0: 0x004F, // Ố -> O
+	0x1ED1: 0x006F, // ố -> o
+	0x1ED2: 0x004F, // Ồ -> O
+	0x1ED3: 0x006F, // ồ -> o
+	0x1ED4: 0x004F, // Ổ -> O
+	0x1ED5: 0x006F, // ổ -> o
+	0x1ED6: 0x004F, // Ỗ -> O
+	0x1ED7: 0x006F, // ỗ -> o
+	0x1ED8: 0x004F, // Ộ -> O
+	0x1ED9: 0x006F, // ộ -> o
+	0x1EDA: 0x004F, // Ớ -> O
+	0x1EDB: 0x006F, // ớ -> o
+	0x1EDC: 0x004F, // Ờ -> O
+	0x1EDD: 0x006F, // ờ -> o
+	0x1EDE: 0x004F, // Ở -> O
+	0x1EDF: 0x006F, // ở -> o
+	0x1EE0: 0x004F, // Ỡ -> O
+	0x1EE1: 0x006F, // ỡ -> o
+	0x1EE2: 0x004F, // Ợ -> O
+	0x1EE3: 0x006F, // ợ -> o
+	0x1EE4: 0x0055, // Ụ -> U
+	0x1EE5: 0x0075, // ụ -> u
+	0x1EE6: 0x0055, // Ủ -> U
+	0x1EE7: 0x0075, // ủ -> u
+	0x1EE8: 0x0055, // Ứ -> U
+	0x1EE9: 0x0075, // ứ -> u
+	0x1EEA: 0x0055, // Ừ -> U
+	0x1EEB: 0x0075, // ừ -> u
+	0x1EEC: 0x0055, // Ử -> U
+	0x1EED: 0x0075, // ử -> u
+	0x1EEE: 0x0055, // Ữ -> U
+	0x1EEF: 0x0075, // ữ -> u
+	0x1EF0: 0x0055, // Ự -> U
+	0x1EF1: 0x0075, // ự -> u
+	0x1EF2: 0x0059, // Ỳ -> Y
+	0x1EF3: 0x0079, // ỳ -> y
+	0x1EF4: 0x0059, // Ỵ -> Y
+	0x1EF5: 0x0079, // ỵ -> y
+	0x1EF6: 0x0059, // Ỷ -> Y
+	0x1EF7: 0x0079, // ỷ -> y
+	0x1EF8: 0x0059, // Ỹ -> Y
+	0x1EF9: 0x0079, // ỹ -> y
+	0x1F00: 0x03B1, // ἀ -> α
+	0x1F01: 0x03B1, // ἁ -> α
+	0x1F02: 0x03B1, // ἂ -> α
+	0x1F03: 0x03B1, // ἃ -> α
+	0x1F04: 0x03B1, // ἄ -> α
+	0x1F05: 0x03B1, // ἅ -> α
+	0x1F06: 0x03B1, // ἆ -> α
+	0x1F07: 0x03B1, // ἇ -> α
+	0x1F08: 0x0391, // Ἀ -> Α
+	0x1F09: 0x0391, // Ἁ -> Α
+	0x1F0A: 0x0391, // Ἂ -> Α
+	0x1F0B: 0x0391, // Ἃ -> Α
+	0x1F0C: 0x0391, // Ἄ -> Α
+	0x1F0D: 0x0391, // Ἅ -> Α
+	0x1F0E: 0x0391, // Ἆ -> Α
+	0x1F0F: 0x0391, // Ἇ -> Α
+	0x1F10: 0x03B5, // ἐ -> ε
+	0x1F11: 0x03B5, // ἑ -> ε
+	0x1F12: 0x03B5, // ἒ -> ε
+	0x1F13: 0x03B5, // ἓ -> ε
+	0x1F14: 0x03B5, // ἔ -> ε
+	0x1F15: 0x03B5, // ἕ -> ε
+	0x1F18: 0x0395, // Ἐ -> Ε
+	0x1F19: 0x0395, // Ἑ -> Ε
+	0x1F1A: 0x0395, // Ἒ -> Ε
+	0x1F1B: 0x0395, // Ἓ -> Ε
+	0x1F1C: 0x0395, // Ἔ -> Ε
+	0x1F1D: 0x0395, // Ἕ -> Ε
+	0x1F20: 0x03B7, // ἠ -> η
+	0x1F21: 0x03B7, // ἡ -> η
+	0x1F22: 0x03B7, // ἢ -> η
+	0x1F23: 0x03B7, // ἣ -> η
+	0x1F24: 0x03B7, // ἤ -> η
+	0x1F25: 0x03B7, // ἥ -> η
+	0x1F26: 0x03B7, // ἦ -> η
+	0x1F27: 0x03B7, // ἧ -> η
+	0x1F28: 0x0397, // Ἠ -> Η
+	0x1F29: 0x0397, // Ἡ -> Η
+	0x1F2A: 0x0397, // Ἢ -> Η
+	0x1F2B: 0x0397, // Ἣ -> Η
+	0x1F2C: 0x0397, // Ἤ -> Η
+	0x1F2D: 0x0397, // Ἥ -> Η
+	0x1F2E: 0x0397, // Ἦ -> Η
+	0x1F2F: 0x0397, // Ἧ -> Η
+	0x1F30: 0x03B9, // ἰ -> ι
+	0x1F31: 0x03B9, // ἱ -> ι
+	0x1F32: 0x03B9, // ἲ -> ι
+	0x1F33: 0x03B9, // ἳ -> ι
+	0x1F34: 0x03B9, // ἴ -> ι
+	0x1F35: 0x03B9, // ἵ -> ι
+	0x1F36: 0x03B9, // ἶ -> ι
+	0x1F37: 0x03B9, // ἷ -> ι
+	0x1F38: 0x0399, // Ἰ -> Ι
+	0x1F39: 0x0399, // Ἱ -> Ι
+	0x1F3A: 0x0399, // Ἲ -> Ι
+	0x1F3B: 0x0399, // Ἳ -> Ι
+	0x1F3C: 0x0399, // Ἴ -> Ι
+	0x1F3D: 0x0399, // Ἵ -> Ι
+	0x1F3E: 0x0399, // Ἶ -> Ι
+	0x1F3F: 0x0399, // Ἷ -> Ι
+	0x1F40: 0x03BF, // ὀ -> ο
+	0x1F41: 0x03BF, // ὁ -> ο
+	0x1F42: 0x03BF, // ὂ -> ο
+	0x1F43: 0x03BF, // ὃ -> ο
+	0x1F44: 0x03BF, // ὄ -> ο
+	0x1F45: 0x03BF, // ὅ -> ο
+	0x1F48: 0x039F, // Ὀ -> Ο
+	0x1F49: 0x039F, // Ὁ -> Ο
+	0x1F4A: 0x039F, // Ὂ -> Ο
+	0x1F4B: 0x039F, // Ὃ -> Ο
+	0x1F4C: 0x039F, // Ὄ -> Ο
+	0x1F4D: 0x039F, // Ὅ -> Ο
+	0x1F50: 0x03C5, // ὐ -> υ
+	0x1F51: 0x03C5, // ὑ -> υ
+	0x1F52: 0x03C5, // ὒ -> υ
+	0x1F53: 0x03C5, // ὓ -> υ
+	0x1F54: 0x03C5, // ὔ -> υ
+	0x1F55: 0x03C5, // ὕ -> υ
+	0x1F56: 0x03C5, // ὖ -> υ
+	0x1F57: 0x03C5, // ὗ -> υ
+	0x1F59: 0x03A5, // Ὑ -> Υ
+	0x1F5B: 0x03A5, // Ὓ -> Υ
+	0x1F5D: 0x03A5, // Ὕ -> Υ
+	0x1F5F: 0x03A5, // Ὗ -> Υ
+	0x1F60: 0x03C9, // ὠ -> ω
+	0x1F61: 0x03C9, // ὡ -> ω
+	0x1F62: 0x03C9, // ὢ -> ω
+	0x1F63: 0x03C9, // ὣ -> ω
+	0x1F64: 0x03C9, // ὤ -> ω
+	0x1F65: 0x03C9, // ὥ -> ω
+	0x1F66: 0x03C9, // ὦ -> ω
+	0x1F67: 0x03C9, // ὧ -> ω
+	0x1F68: 0x03A9, // Ὠ -> Ω
+	0x1F69: 0x03A9, // Ὡ -> Ω
+	0x1F6A: 0x03A9, // Ὢ -> Ω
+	0x1F6B: 0x03A9, // Ὣ -> Ω
+	0x1F6C: 0x03A9, // Ὤ -> Ω
+	0x1F6D: 0x03A9, // Ὥ -> Ω
+	0x1F6E: 0x03A9, // Ὦ -> Ω
+	0x1F6F: 0x03A9, // Ὧ -> Ω
+	0x1F70: 0x03B1, // ὰ -> α
+	0x1F71: 0x03B1, // ά -> α
+	0x1F72: 0x03B5, // ὲ -> ε
+	0x1F73: 0x03B5, // έ -> ε
+	0x1F74: 0x03B7, // ὴ -> η
+	0x1F75: 0x03B7, // ή -> η
+	0x1F76: 0x03B9, // ὶ -> ι
+	0x1F77: 0x03B9, // ί -> ι
+	0x1F78: 0x03BF, // ὸ -> ο
+	0x1F79: 0x03BF, // ό -> ο
+	0x1F7A: 0x03C5, // ὺ -> υ
+	0x1F7B: 0x03C5, // ύ -> υ
+	0x1F7C: 0x03C9, // ὼ -> ω
+	0x1F7D: 0x03C9, // ώ -> ω
+	0x1F80: 0x03B1, // ᾀ -> α
+	0x1F81: 0x03B1, // ᾁ -> α
+	0x1F82: 0x03B1, // ᾂ -> α
+	0x1F83: 0x03B1, // ᾃ -> α
+	0x1F84: 0x03B1, // ᾄ -> α
+	0x1F85: 0x03B1, // ᾅ -> α
+	0x1F86: 0x03B1, // ᾆ -> α
+	0x1F87: 0x03B1, // ᾇ -> α
+	0x1F88: 0x0391, // ᾈ -> Α
+	0x1F89: 0x0391, // ᾉ -> Α
+	0x1F8A: 0x0391, // ᾊ -> Α
+	0x1F8B: 0x0391, // ᾋ -> Α
+	0x1F8C: 0x0391, // ᾌ -> Α
+	0x1F8D: 0x0391, // ᾍ -> Α
+	0x1F8E: 0x0391, // ᾎ -> Α
+	0x1F8F: 0x0391, // ᾏ -> Α
+	0x1F90: 0x03B7, // ᾐ -> η
+	0x1F91: 0x03B7, // ᾑ -> η
+	0x1F92: 0x03B7, // ᾒ -> η
+	0x1F93: 0x03B7, // ᾓ -> η
+	0x1F94: 0x03B7, // ᾔ -> η
+	0x1F95: 0x03B7, // ᾕ -> η
+	0x1F96: 0x03B7, // ᾖ -> η
+	0x1F97: 0x03B7, // ᾗ -> η
+	0x1F98: 0x0397, // ᾘ -> Η
+	0x1F99: 0x0397, // ᾙ -> Η
+	0x1F9A: 0x0397, // ᾚ -> Η
+	0x1F9B: 0x0397, // ᾛ -> Η
+	0x1F9C: 0x0397, // ᾜ -> Η
+	0x1F9D: 0x0397, // ᾝ -> Η
+	0x1F9E: 0x0397, // ᾞ -> Η
+	0x1F9F: 0x0397, // ᾟ -> Η
+	0x1FA0: 0x03C9, // ᾠ -> ω
+	0x1FA1: 0x03C9, // ᾡ -> ω
+	0x1FA2: 0x03C9, // ᾢ -> ω
+	0x1FA3: 0x03C9, // ᾣ -> ω
+	0x1FA4: 0x03C9, // ᾤ -> ω
+	0x1FA5: 0x03C9, // ᾥ -> ω
+	0x1FA6: 0x03C9, // ᾦ -> ω
+	0x1FA7: 0x03C9, // ᾧ -> ω
+	0x1FA8: 0x03A9, // ᾨ -> Ω
+	0x1FA9: 0x03A9, // ᾩ -> Ω
+	0x1FAA: 0x03A9, // ᾪ -> Ω
+	0x1FAB: 0x03A9, // ᾫ -> Ω
+	0x1FAC: 0x03A9, // ᾬ -> Ω
+	0x1FAD: 0x03A9, // ᾭ -> Ω
+	0x1FAE: 0x03A9, // ᾮ -> Ω
+	0x1FAF: 0x03A9, // ᾯ -> Ω
+	0x1FB0: 0x03B1, // ᾰ -> α
+	0x1FB1: 0x03B1, // ᾱ -> α
+	0x1FB2: 0x03B1, // ᾲ -> α
+	0x1FB3: 0x03B1, // ᾳ -> α
+	0x1FB4: 0x03B1, // ᾴ -> α
+	0x1FB6: 0x03B1, // ᾶ -> α
+	0x1FB7: 0x03B1, // ᾷ -> α
+	0x1FB8: 0x0391, // Ᾰ -> Α
+	0x1FB9: 0x0391, // Ᾱ -> Α
+	0x1FBA: 0x0391, // Ὰ -> Α
+	0x1FBB: 0x0391, // Ά -> Α
+	0x1FBC: 0x0391, // ᾼ -> Α
+	0x1FBE: 0x03B9, // ι -> ι
+	0x1FC1: 0x00A8, // ῁ -> ¨
+	0x1FC2: 0x03B7, // ῂ -> η
+	0x1FC3: 0x03B7, // ῃ -> η
+	0x1FC4: 0x03B7, // ῄ -> η
+	0x1FC6: 0x03B7, // ῆ -> η
+	0x1FC7: 0x03B7, // ῇ -> η
+	0x1FC8: 0x0395, // Ὲ -> Ε
+	0x1FC9: 0x0395, // Έ -> Ε
+	0x1FCA: 0x0397, // Ὴ -> Η
+	0x1FCB: 0x0397, // Ή -> Η
+	0x1FCC: 0x0397, // ῌ -> Η
+	0x1FCD: 0x1FBF, // ῍ -> ᾿
+	0x1FCE: 0x1FBF, // ῎ -> ᾿
+	0x1FCF: 0x1FBF, // ῏ -> ᾿
+	0x1FD0: 0x03B9, // ῐ -> ι
+	0x1FD1: 0x03B9, // ῑ -> ι
+	0x1FD2: 0x03B9, // ῒ -> ι
+	0x1FD3: 0x03B9, // ΐ -> ι
+	0x1FD6: 0x03B9, // ῖ -> ι
+	0x1FD7: 0x03B9, // ῗ -> ι
+	0x1FD8: 0x0399, // Ῐ -> Ι
+	0x1FD9: 0x0399, // Ῑ -> Ι
+	0x1FDA: 0x0399, // Ὶ -> Ι
+	0x1FDB: 0x0399, // Ί -> Ι
+	0x1FDD: 0x1FFE, // ῝ -> ῾
+	0x1FDE: 0x1FFE, // ῞ -> ῾
+	0x1FDF: 0x1FFE, // ῟ -> ῾
+	0x1FE0: 0x03C5, // ῠ -> υ
+	0x1FE1: 0x03C5, // ῡ -> υ
+	0x1FE2: 0x03C5, // ῢ -> υ
+	0x1FE3: 0x03C5, // ΰ -> υ
+	0x1FE4: 0x03C1, // ῤ -> ρ
+	0x1FE5: 0x03C1, // ῥ -> ρ
+	0x1FE6: 0x03C5, // ῦ -> υ
+	0x1FE7: 0x03C5, // ῧ -> υ
+	0x1FE8: 0x03A5, // Ῠ -> Υ
+	0x1FE9: 0x03A5, // Ῡ -> Υ
+	0x1FEA: 0x03A5, // Ὺ -> Υ
+	0x1FEB: 0x03A5, // Ύ -> Υ
+	0x1FEC: 0x03A1, // Ῥ -> Ρ
+	0x1FED: 0x00A8, // ῭ -> ¨
+	0x1FEE: 0x00A8, // ΅ -> ¨
+	0x1FEF: 0x0060, // ` -> `
+	0x1FF2: 0x03C9, // ῲ -> ω
+	0x1FF3: 0x03C9, // ῳ -> ω
+	0x1FF4: 0x03C9, // ῴ -> ω
+	0x1FF6: 0x03C9, // ῶ -> ω
+	0x1FF7: 0x03C9, // ῷ -> ω
+	0x1FF8: 0x039F, // Ὸ -> Ο
+	0x1FF9: 0x039F, // Ό -> Ο
+	0x1FFA: 0x03A9, // Ὼ -> Ω
+	0x1FFB: 0x03A9, // Ώ -> Ω
+	0x1FFC: 0x03A9, // ῼ -> Ω
+	0x1FFD: 0x00B4, // ´ -> ´
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2021 Nikita Krasnikov
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strcase
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTransliterate(t *testing.T) {
+	type args struct {
+		str string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "ascii",
+			args: args{str: "field_name"},
+			want: "field_name",
+		},
+		{
+			name: "german",
+			args: args{str: "Größe des Kontos"},
+			want: "Grosse des Kontos",
+		},
+		{
+			name: "spanish",
+			args: args{str: "Número de pedido"},
+			want: "Numero de pedido",
+		},
+		{
+			name: "vietnamese",
+			args: args{str: "Tiếng Việt"},
+			want: "Tieng Viet",
+		},
+		{
+			name: "nordic",
+			args: args{str: "Ærø Øl"},
+			want: "Aero Ol",
+		},
+		{
+			name: "capital sharp s",
+			args: args{str: "GROẞE"},
+			want: "GROSSE",
+		},
+		{
+			name: "digraphs",
+			args: args{str: "ǅemal ǄEMAL ǆ Ǉubljana ǈubljana Ǌego ǋego"},
+			want: "Dzemal DZEMAL dz Ljubljana Ljubljana Njego Njego",
+		},
+		{
+			name: "ligatures",
+			args: args{str: "ﬁle ﬂow oﬀset eﬃcient baﬄe ﬆop"},
+			want: "file flow offset efficient baffle stop",
+		},
+		{
+			name: "russian",
+			args: args{str: "Щука и ёж"},
+			want: "Shchuka i yozh",
+		},
+		{
+			name: "russian upper",
+			args: args{str: "ЩУКА ЩИ"},
+			want: "SHCHUKA SHCHI",
+		},
+		{
+			name: "russian signs",
+			args: args{str: "объём"},
+			want: "obyom",
+		},
+		{
+			name: "ukrainian",
+			args: args{str: "Їжак"},
+			want: "Yizhak",
+		},
+		{
+			name: "greek",
+			args: args{str: "Όνομα χρήστη"},
+			want: "Onoma christi",
+		},
+		{
+			name: "combining marks",
+			args: args{str: "Café"},
+			want: "Cafe",
+		},
+		{
+			name: "space and dash",
+			args: args{str: "a b–c"},
+			want: "a b-c",
+		},
+		{
+			name: "untransliterable",
+			args: args{str: "user用户"},
+			want: "user",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Transliterate(tt.args.str); got != tt.want {
+				t.Errorf("Transliterate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransliterateStrict(t *testing.T) {
+	got, err := TransliterateStrict("Größe")
+	if err != nil || got != "Grosse" {
+		t.Errorf("TransliterateStrict() = %v, %v, want Grosse, <nil>", got, err)
+	}
+
+	got, err = TransliterateStrict("ǅﬁle")
+	if err != nil || got != "Dzfile" {
+		t.Errorf("TransliterateStrict() = %v, %v, want Dzfile, <nil>", got, err)
+	}
+
+	_, err = TransliterateStrict("user用户")
+	var tErr *TransliterationError
+	if !errors.As(err, &tErr) {
+		t.Fatalf("TransliterateStrict() error = %v, want *TransliterationError", err)
+	}
+	if tErr.Rune != '用' || tErr.Index != 4 {
+		t.Errorf("TransliterateStrict() error = %+v, want rune '用' at index 4", tErr)
+	}
+}
+
+func TestConverterTransliteration(t *testing.T) {
+	c := NewConverter(nil)
+	c.SetTransliteration(true)
+
+	tests := []struct {
+		name string
+		str  string
+		f    func(string) string
+		want string
+	}{
+		{name: "snake_case", str: "Größe des Kontos", f: c.ToSnakeCase, want: "grosse_des_kontos"},
+		{name: "kebab-case", str: "Número de pedido", f: c.ToKebabCase, want: "numero-de-pedido"},
+		{name: "camelCase", str: "Имя пользователя", f: c.ToCamelCase, want: "imyaPolzovatelya"},
+		{name: "PascalCase", str: "ÆbleØl", f: c.ToPascalCase, want: "AebleOl"},
+		{name: "global unchanged", str: "Größe des Kontos", f: ToSnakeCase, want: "größe_des_kontos"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f(tt.str); got != tt.want {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}